@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/mockaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// mockAWSConfigureWrapper returns a ConfigureWrapper that points the
+// provider at the in-memory AWS backend b.
+//
+// Before configuration, static credentials and the backend's Region are
+// supplied unless the test's provider configuration sets its own, and the
+// backend's HTTP client is installed so that the provider's credential
+// validation never leaves the process. After configuration, the backend's
+// Smithy middleware is appended to every AWS API client and waiters are told
+// not to delay between state change refreshes.
+func mockAWSConfigureWrapper(provider *schema.Provider, b *mockaws.Backend, t *testing.T) ConfigureWrapper {
+	return func(next schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			for k, v := range map[string]string{
+				"access_key": mockaws.AccessKeyID,
+				"region":     b.Region(),
+				"secret_key": mockaws.SecretAccessKey,
+			} {
+				if d.Get(k).(string) != "" {
+					continue
+				}
+				if err := d.Set(k, v); err != nil {
+					return nil, sdkdiag.AppendFromErr(diags, err)
+				}
+			}
+
+			var meta *conns.AWSClient
+			if v, ok := provider.Meta().(*conns.AWSClient); ok {
+				meta = v
+			} else {
+				meta = new(conns.AWSClient)
+			}
+			meta.SetHTTPClient(ctx, b.HTTPClient())
+			provider.SetMeta(meta)
+
+			if v, ds := next(ctx, d); ds.HasError() {
+				return nil, append(diags, ds...)
+			} else {
+				meta = v.(*conns.AWSClient)
+			}
+
+			meta.AppendAPIOptions(ctx, b.Middleware())
+			meta.SetZeroWaitDelay(true)
+
+			// Make the configured client available to ProviderMeta so that
+			// CheckExists and CheckDestroy functions query the backend.
+			providerMetas.Lock()
+			providerMetas[t.Name()] = &providerMetaStore{provider: provider, meta: meta}
+			providerMetas.Unlock()
+
+			return meta, diags
+		}
+	}
+}
+
+// ProtoV5ProviderFactoriesWithMockAWS returns Plugin Protocol v5 provider
+// factories for the AWS provider that serve every AWS API call from the
+// in-memory backend b, so that a test can run end-to-end with no network
+// access and no AWS credentials.
+//
+// Operations the backend does not implement fail with an error naming the
+// service and operation. Because the backend is stateful, share one backend
+// across all steps of a test and create a new backend for each test.
+//
+// VCR is never composed with the mock backend, and the given
+// ConfigureWrappers run inside the mock backend wrapper.
+//
+// Use [ProviderMeta] (not [Provider]) to obtain the *conns.AWSClient in
+// CheckExists and CheckDestroy functions, and the backend's AccountID and
+// Region in place of [AccountID] and [Region].
+//
+// Example:
+//
+//	backend := mockaws.NewBackend(mockaws.WithRegion(acctest.Region()))
+//	resource.ParallelTest(t, resource.TestCase{
+//	    ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesWithMockAWS(ctx, t, backend),
+//	    Steps: []resource.TestStep{ ... },
+//	})
+func ProtoV5ProviderFactoriesWithMockAWS(
+	ctx context.Context,
+	t *testing.T,
+	b *mockaws.Backend,
+	wrappers ...ConfigureWrapper,
+) map[string]func() (tfprotov5.ProviderServer, error) {
+	t.Helper()
+
+	disableVCRAutoWrap(t)
+	t.Cleanup(func() {
+		providerMetas.Lock()
+		delete(providerMetas, t.Name())
+		providerMetas.Unlock()
+	})
+
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		ProviderName: func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			chain := append([]ConfigureWrapper{mockAWSConfigureWrapper(primary, b, t)}, wrappers...)
+			primary.ConfigureContextFunc = chainConfigureWrappers(primary.ConfigureContextFunc, chain...)
+
+			return providerServerFactory(), nil
+		},
+	}
+}
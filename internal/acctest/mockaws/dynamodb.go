@@ -0,0 +1,445 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+type dynamoDBState struct {
+	// tables are keyed by table name.
+	tables map[string]*dynamoDBTable
+}
+
+// dynamoDBTable is a table. Tables are ACTIVE as soon as they are created or updated.
+type dynamoDBTable struct {
+	description dynamodbtypes.TableDescription
+	pitr        dynamodbtypes.PointInTimeRecoveryDescription
+	ttl         dynamodbtypes.TimeToLiveDescription
+	tags        tags
+}
+
+func (b *Backend) registerDynamoDB() {
+	b.dynamodb.tables = make(map[string]*dynamoDBTable)
+
+	b.register(dynamodb.ServiceID, "CreateTable", handle(b.createTable))
+	b.register(dynamodb.ServiceID, "DeleteTable", handle(b.deleteTable))
+	b.register(dynamodb.ServiceID, "DescribeContinuousBackups", handle(b.describeContinuousBackups))
+	b.register(dynamodb.ServiceID, "DescribeTable", handle(b.describeTable))
+	b.register(dynamodb.ServiceID, "DescribeTimeToLive", handle(b.describeTimeToLive))
+	b.register(dynamodb.ServiceID, "ListTables", handle(b.listTables))
+	b.register(dynamodb.ServiceID, "ListTagsOfResource", handle(b.listTagsOfResource))
+	b.register(dynamodb.ServiceID, "TagResource", handle(b.tagDynamoDBResource))
+	b.register(dynamodb.ServiceID, "UntagResource", handle(b.untagDynamoDBResource))
+	b.register(dynamodb.ServiceID, "UpdateContinuousBackups", handle(b.updateContinuousBackups))
+	b.register(dynamodb.ServiceID, "UpdateTable", handle(b.updateTable))
+	b.register(dynamodb.ServiceID, "UpdateTimeToLive", handle(b.updateTimeToLive))
+}
+
+func errTableNotFound(name string) error {
+	return &dynamodbtypes.ResourceNotFoundException{Message: aws.String(fmt.Sprintf("Requested resource not found: Table: %s not found", name))}
+}
+
+// findTable returns the table with the specified name or ARN. b.mu must be held.
+func (b *Backend) findTable(name string) (*dynamoDBTable, error) {
+	if v, ok := b.dynamodb.tables[name]; ok {
+		return v, nil
+	}
+
+	for _, v := range b.dynamodb.tables {
+		if aws.ToString(v.description.TableArn) == name {
+			return v, nil
+		}
+	}
+
+	return nil, errTableNotFound(name)
+}
+
+func (b *Backend) createTable(_ context.Context, input *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.TableName)
+	if _, ok := b.dynamodb.tables[name]; ok {
+		return nil, &dynamodbtypes.ResourceInUseException{Message: aws.String("Table already exists: " + name)}
+	}
+
+	billingMode := input.BillingMode
+	if billingMode == "" {
+		billingMode = dynamodbtypes.BillingModeProvisioned
+	}
+	tableClass := input.TableClass
+	if tableClass == "" {
+		tableClass = dynamodbtypes.TableClassStandard
+	}
+
+	arn := b.arn("dynamodb", b.region, "table/"+name)
+	description := dynamodbtypes.TableDescription{
+		AttributeDefinitions:      input.AttributeDefinitions,
+		BillingModeSummary:        &dynamodbtypes.BillingModeSummary{BillingMode: billingMode},
+		CreationDateTime:          aws.Time(time.Now()),
+		DeletionProtectionEnabled: aws.Bool(aws.ToBool(input.DeletionProtectionEnabled)),
+		ItemCount:                 aws.Int64(0),
+		KeySchema:                 input.KeySchema,
+		OnDemandThroughput:        input.OnDemandThroughput,
+		ProvisionedThroughput:     provisionedThroughputDescription(input.ProvisionedThroughput),
+		TableArn:                  aws.String(arn),
+		TableClassSummary:         &dynamodbtypes.TableClassSummary{TableClass: tableClass},
+		TableId:                   aws.String(fmt.Sprintf("00000000-0000-0000-0000-%012d", len(b.dynamodb.tables)+1)),
+		TableName:                 aws.String(name),
+		TableSizeBytes:            aws.Int64(0),
+		TableStatus:               dynamodbtypes.TableStatusActive,
+		WarmThroughput: &dynamodbtypes.TableWarmThroughputDescription{
+			ReadUnitsPerSecond:  aws.Int64(12000),
+			Status:              dynamodbtypes.TableStatusActive,
+			WriteUnitsPerSecond: aws.Int64(4000),
+		},
+	}
+	for _, v := range input.GlobalSecondaryIndexes {
+		description.GlobalSecondaryIndexes = append(description.GlobalSecondaryIndexes, dynamodbtypes.GlobalSecondaryIndexDescription{
+			IndexArn:              aws.String(arn + "/index/" + aws.ToString(v.IndexName)),
+			IndexName:             v.IndexName,
+			IndexStatus:           dynamodbtypes.IndexStatusActive,
+			KeySchema:             v.KeySchema,
+			OnDemandThroughput:    v.OnDemandThroughput,
+			Projection:            v.Projection,
+			ProvisionedThroughput: provisionedThroughputDescription(v.ProvisionedThroughput),
+		})
+	}
+	for _, v := range input.LocalSecondaryIndexes {
+		description.LocalSecondaryIndexes = append(description.LocalSecondaryIndexes, dynamodbtypes.LocalSecondaryIndexDescription{
+			IndexArn:   aws.String(arn + "/index/" + aws.ToString(v.IndexName)),
+			IndexName:  v.IndexName,
+			KeySchema:  v.KeySchema,
+			Projection: v.Projection,
+		})
+	}
+	if v := input.SSESpecification; v != nil && aws.ToBool(v.Enabled) {
+		description.SSEDescription = b.sseDescription(v)
+	}
+	if v := input.StreamSpecification; v != nil && aws.ToBool(v.StreamEnabled) {
+		description.StreamSpecification = v
+		description.LatestStreamLabel = aws.String(time.Now().UTC().Format("2006-01-02T15:04:05.000"))
+		description.LatestStreamArn = aws.String(arn + "/stream/" + aws.ToString(description.LatestStreamLabel))
+	}
+
+	table := &dynamoDBTable{
+		description: description,
+		pitr: dynamodbtypes.PointInTimeRecoveryDescription{
+			PointInTimeRecoveryStatus: dynamodbtypes.PointInTimeRecoveryStatusDisabled,
+		},
+		ttl: dynamodbtypes.TimeToLiveDescription{
+			TimeToLiveStatus: dynamodbtypes.TimeToLiveStatusDisabled,
+		},
+	}
+	for _, tag := range input.Tags {
+		table.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+	}
+	b.dynamodb.tables[name] = table
+
+	return &dynamodb.CreateTableOutput{TableDescription: &table.description}, nil
+}
+
+func provisionedThroughputDescription(apiObject *dynamodbtypes.ProvisionedThroughput) *dynamodbtypes.ProvisionedThroughputDescription {
+	description := &dynamodbtypes.ProvisionedThroughputDescription{
+		NumberOfDecreasesToday: aws.Int64(0),
+		ReadCapacityUnits:      aws.Int64(0),
+		WriteCapacityUnits:     aws.Int64(0),
+	}
+	if apiObject != nil {
+		description.ReadCapacityUnits = apiObject.ReadCapacityUnits
+		description.WriteCapacityUnits = apiObject.WriteCapacityUnits
+	}
+
+	return description
+}
+
+func (b *Backend) sseDescription(apiObject *dynamodbtypes.SSESpecification) *dynamodbtypes.SSEDescription {
+	keyARN := aws.ToString(apiObject.KMSMasterKeyId)
+	if keyARN == "" {
+		keyARN = b.arn("kms", b.region, "key/00000000-0000-0000-0000-000000000000")
+	}
+
+	return &dynamodbtypes.SSEDescription{
+		KMSMasterKeyArn: aws.String(keyARN),
+		SSEType:         dynamodbtypes.SSETypeKms,
+		Status:          dynamodbtypes.SSEStatusEnabled,
+	}
+}
+
+func (b *Backend) describeTable(_ context.Context, input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	description := table.description
+
+	return &dynamodb.DescribeTableOutput{Table: &description}, nil
+}
+
+func (b *Backend) listTables(_ context.Context, _ *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return &dynamodb.ListTablesOutput{TableNames: slices.Sorted(maps.Keys(b.dynamodb.tables))}, nil
+}
+
+func (b *Backend) updateTable(_ context.Context, input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	description := &table.description
+	if input.AttributeDefinitions != nil {
+		description.AttributeDefinitions = input.AttributeDefinitions
+	}
+	if input.BillingMode != "" {
+		description.BillingModeSummary = &dynamodbtypes.BillingModeSummary{BillingMode: input.BillingMode}
+		if input.BillingMode == dynamodbtypes.BillingModePayPerRequest {
+			description.ProvisionedThroughput = provisionedThroughputDescription(nil)
+		}
+	}
+	if input.DeletionProtectionEnabled != nil {
+		description.DeletionProtectionEnabled = input.DeletionProtectionEnabled
+	}
+	if input.OnDemandThroughput != nil {
+		description.OnDemandThroughput = input.OnDemandThroughput
+	}
+	if input.ProvisionedThroughput != nil {
+		description.ProvisionedThroughput = provisionedThroughputDescription(input.ProvisionedThroughput)
+	}
+	if v := input.SSESpecification; v != nil {
+		if aws.ToBool(v.Enabled) {
+			description.SSEDescription = b.sseDescription(v)
+		} else {
+			description.SSEDescription = nil
+		}
+	}
+	if v := input.StreamSpecification; v != nil {
+		if aws.ToBool(v.StreamEnabled) {
+			description.StreamSpecification = v
+			description.LatestStreamLabel = aws.String(time.Now().UTC().Format("2006-01-02T15:04:05.000"))
+			description.LatestStreamArn = aws.String(aws.ToString(description.TableArn) + "/stream/" + aws.ToString(description.LatestStreamLabel))
+		} else {
+			description.StreamSpecification = nil
+		}
+	}
+	if input.TableClass != "" {
+		description.TableClassSummary = &dynamodbtypes.TableClassSummary{TableClass: input.TableClass}
+	}
+	for _, update := range input.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			v := update.Create
+			description.GlobalSecondaryIndexes = append(description.GlobalSecondaryIndexes, dynamodbtypes.GlobalSecondaryIndexDescription{
+				IndexArn:              aws.String(aws.ToString(description.TableArn) + "/index/" + aws.ToString(v.IndexName)),
+				IndexName:             v.IndexName,
+				IndexStatus:           dynamodbtypes.IndexStatusActive,
+				KeySchema:             v.KeySchema,
+				OnDemandThroughput:    v.OnDemandThroughput,
+				Projection:            v.Projection,
+				ProvisionedThroughput: provisionedThroughputDescription(v.ProvisionedThroughput),
+			})
+		case update.Update != nil:
+			for i, gsi := range description.GlobalSecondaryIndexes {
+				if aws.ToString(gsi.IndexName) == aws.ToString(update.Update.IndexName) {
+					if update.Update.ProvisionedThroughput != nil {
+						description.GlobalSecondaryIndexes[i].ProvisionedThroughput = provisionedThroughputDescription(update.Update.ProvisionedThroughput)
+					}
+					if update.Update.OnDemandThroughput != nil {
+						description.GlobalSecondaryIndexes[i].OnDemandThroughput = update.Update.OnDemandThroughput
+					}
+				}
+			}
+		case update.Delete != nil:
+			description.GlobalSecondaryIndexes = slices.DeleteFunc(description.GlobalSecondaryIndexes, func(v dynamodbtypes.GlobalSecondaryIndexDescription) bool {
+				return aws.ToString(v.IndexName) == aws.ToString(update.Delete.IndexName)
+			})
+		}
+	}
+
+	output := *description
+
+	return &dynamodb.UpdateTableOutput{TableDescription: &output}, nil
+}
+
+func (b *Backend) deleteTable(_ context.Context, input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToBool(table.description.DeletionProtectionEnabled) {
+		return nil, &dynamodbtypes.ResourceInUseException{Message: aws.String("Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")}
+	}
+
+	delete(b.dynamodb.tables, aws.ToString(table.description.TableName))
+
+	output := table.description
+	output.TableStatus = dynamodbtypes.TableStatusDeleting
+
+	return &dynamodb.DeleteTableOutput{TableDescription: &output}, nil
+}
+
+func (b *Backend) describeContinuousBackups(_ context.Context, input *dynamodb.DescribeContinuousBackupsInput) (*dynamodb.DescribeContinuousBackupsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, &dynamodbtypes.TableNotFoundException{Message: aws.String("Table not found: " + aws.ToString(input.TableName))}
+	}
+
+	pitr := table.pitr
+
+	return &dynamodb.DescribeContinuousBackupsOutput{
+		ContinuousBackupsDescription: &dynamodbtypes.ContinuousBackupsDescription{
+			ContinuousBackupsStatus:        dynamodbtypes.ContinuousBackupsStatusEnabled,
+			PointInTimeRecoveryDescription: &pitr,
+		},
+	}, nil
+}
+
+func (b *Backend) updateContinuousBackups(_ context.Context, input *dynamodb.UpdateContinuousBackupsInput) (*dynamodb.UpdateContinuousBackupsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, &dynamodbtypes.TableNotFoundException{Message: aws.String("Table not found: " + aws.ToString(input.TableName))}
+	}
+
+	if v := input.PointInTimeRecoverySpecification; v != nil {
+		if aws.ToBool(v.PointInTimeRecoveryEnabled) {
+			now := time.Now()
+			table.pitr = dynamodbtypes.PointInTimeRecoveryDescription{
+				EarliestRestorableDateTime: aws.Time(now),
+				LatestRestorableDateTime:   aws.Time(now),
+				PointInTimeRecoveryStatus:  dynamodbtypes.PointInTimeRecoveryStatusEnabled,
+				RecoveryPeriodInDays:       aws.Int32(35),
+			}
+			if v.RecoveryPeriodInDays != nil {
+				table.pitr.RecoveryPeriodInDays = v.RecoveryPeriodInDays
+			}
+		} else {
+			table.pitr = dynamodbtypes.PointInTimeRecoveryDescription{
+				PointInTimeRecoveryStatus: dynamodbtypes.PointInTimeRecoveryStatusDisabled,
+			}
+		}
+	}
+
+	pitr := table.pitr
+
+	return &dynamodb.UpdateContinuousBackupsOutput{
+		ContinuousBackupsDescription: &dynamodbtypes.ContinuousBackupsDescription{
+			ContinuousBackupsStatus:        dynamodbtypes.ContinuousBackupsStatusEnabled,
+			PointInTimeRecoveryDescription: &pitr,
+		},
+	}, nil
+}
+
+func (b *Backend) describeTimeToLive(_ context.Context, input *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := table.ttl
+
+	return &dynamodb.DescribeTimeToLiveOutput{TimeToLiveDescription: &ttl}, nil
+}
+
+func (b *Backend) updateTimeToLive(_ context.Context, input *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.TimeToLiveSpecification; v != nil {
+		if aws.ToBool(v.Enabled) {
+			table.ttl = dynamodbtypes.TimeToLiveDescription{
+				AttributeName:    v.AttributeName,
+				TimeToLiveStatus: dynamodbtypes.TimeToLiveStatusEnabled,
+			}
+		} else {
+			table.ttl = dynamodbtypes.TimeToLiveDescription{
+				TimeToLiveStatus: dynamodbtypes.TimeToLiveStatusDisabled,
+			}
+		}
+	}
+
+	return &dynamodb.UpdateTimeToLiveOutput{TimeToLiveSpecification: input.TimeToLiveSpecification}, nil
+}
+
+func (b *Backend) listTagsOfResource(_ context.Context, input *dynamodb.ListTagsOfResourceInput) (*dynamodb.ListTagsOfResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjects []dynamodbtypes.Tag
+	for _, k := range table.tags.sortedKeys() {
+		apiObjects = append(apiObjects, dynamodbtypes.Tag{Key: aws.String(k), Value: aws.String(table.tags[k])})
+	}
+
+	return &dynamodb.ListTagsOfResourceOutput{Tags: apiObjects}, nil
+}
+
+func (b *Backend) tagDynamoDBResource(_ context.Context, input *dynamodb.TagResourceInput) (*dynamodb.TagResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		table.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+	}
+
+	return &dynamodb.TagResourceOutput{}, nil
+}
+
+func (b *Backend) untagDynamoDBResource(_ context.Context, input *dynamodb.UntagResourceInput) (*dynamodb.UntagResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	table, err := b.findTable(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, err
+	}
+
+	table.tags.remove(input.TagKeys)
+
+	return &dynamodb.UntagResourceOutput{}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// HTTPClient returns an HTTP client that serves the requests that bypass the backend's middleware and rejects all others.
+// It never makes a network connection.
+func (b *Backend) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: transport{backend: b},
+	}
+}
+
+type transport struct {
+	backend *Backend
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+
+	host := req.URL.Hostname()
+	switch {
+	case strings.HasPrefix(host, "sts.") && req.Method == http.MethodPost:
+		if err := req.ParseForm(); err != nil {
+			return nil, err
+		}
+		if req.PostForm.Get("Action") == "GetCallerIdentity" {
+			return t.response(req, http.StatusOK, nil, t.backend.getCallerIdentityXML()), nil
+		}
+
+	case isS3Host(host) && req.Method == http.MethodHead:
+		return t.headBucket(req), nil
+	}
+
+	// Not retryable.
+	body := fmt.Sprintf("mockaws: no network access: %s %s", req.Method, req.URL)
+	return t.response(req, http.StatusNotImplemented, http.Header{"Content-Type": []string{"text/plain"}}, body), nil
+}
+
+func (t transport) headBucket(req *http.Request) *http.Response {
+	bucket := bucketFromRequest(req)
+
+	t.backend.mu.Lock()
+	_, ok := t.backend.s3.buckets[bucket]
+	t.backend.mu.Unlock()
+
+	if !ok {
+		return t.response(req, http.StatusNotFound, nil, "")
+	}
+
+	return t.response(req, http.StatusOK, http.Header{
+		"X-Amz-Bucket-Region": []string{t.backend.region},
+	}, "")
+}
+
+func (t transport) response(req *http.Request, statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("X-Amzn-Requestid", t.backend.nextRequestID())
+	if body != "" && header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "text/xml")
+	}
+
+	return &http.Response{
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Header:        header,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+	}
+}
+
+func isS3Host(host string) bool {
+	return strings.HasPrefix(host, "s3.") || strings.Contains(host, ".s3.") || strings.Contains(host, ".s3-")
+}
+
+// bucketFromRequest returns the bucket name from a virtual-hosted-style or path-style S3 request.
+func bucketFromRequest(req *http.Request) string {
+	host := req.URL.Hostname()
+	if i := strings.Index(host, ".s3."); i > 0 {
+		return host[:i]
+	}
+	if i := strings.Index(host, ".s3-"); i > 0 {
+		return host[:i]
+	}
+
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+
+	return bucket
+}
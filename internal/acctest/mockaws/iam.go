@@ -0,0 +1,744 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+const iamMaxPolicyVersions = 5
+
+type iamState struct {
+	entityID int
+	// policies are keyed by policy ARN.
+	policies map[string]*iamPolicy
+	// roles are keyed by role name.
+	roles map[string]*iamRole
+}
+
+type iamRole struct {
+	role     iamtypes.Role
+	policies map[string]string
+	// attachedPolicies are policy ARNs.
+	attachedPolicies []string
+	tags             tags
+}
+
+type iamPolicy struct {
+	policy   iamtypes.Policy
+	versions []iamtypes.PolicyVersion
+	tags     tags
+}
+
+func (b *Backend) registerIAM() {
+	b.iam.policies = make(map[string]*iamPolicy)
+	b.iam.roles = make(map[string]*iamRole)
+
+	b.register(iam.ServiceID, "AttachRolePolicy", handle(b.attachRolePolicy))
+	b.register(iam.ServiceID, "CreatePolicy", handle(b.createPolicy))
+	b.register(iam.ServiceID, "CreatePolicyVersion", handle(b.createPolicyVersion))
+	b.register(iam.ServiceID, "CreateRole", handle(b.createRole))
+	b.register(iam.ServiceID, "DeletePolicy", handle(b.deletePolicy))
+	b.register(iam.ServiceID, "DeletePolicyVersion", handle(b.deletePolicyVersion))
+	b.register(iam.ServiceID, "DeleteRole", handle(b.deleteRole))
+	b.register(iam.ServiceID, "DeleteRolePermissionsBoundary", handle(b.deleteRolePermissionsBoundary))
+	b.register(iam.ServiceID, "DeleteRolePolicy", handle(b.deleteRolePolicy))
+	b.register(iam.ServiceID, "DetachRolePolicy", handle(b.detachRolePolicy))
+	b.register(iam.ServiceID, "GetPolicy", handle(b.getPolicy))
+	b.register(iam.ServiceID, "GetPolicyVersion", handle(b.getPolicyVersion))
+	b.register(iam.ServiceID, "GetRole", handle(b.getRole))
+	b.register(iam.ServiceID, "GetRolePolicy", handle(b.getRolePolicy))
+	b.register(iam.ServiceID, "ListAttachedRolePolicies", handle(b.listAttachedRolePolicies))
+	b.register(iam.ServiceID, "ListInstanceProfilesForRole", handle(b.listInstanceProfilesForRole))
+	b.register(iam.ServiceID, "ListPolicyTags", handle(b.listPolicyTags))
+	b.register(iam.ServiceID, "ListPolicyVersions", handle(b.listPolicyVersions))
+	b.register(iam.ServiceID, "ListRolePolicies", handle(b.listRolePolicies))
+	b.register(iam.ServiceID, "ListRoleTags", handle(b.listRoleTags))
+	b.register(iam.ServiceID, "PutRolePermissionsBoundary", handle(b.putRolePermissionsBoundary))
+	b.register(iam.ServiceID, "PutRolePolicy", handle(b.putRolePolicy))
+	b.register(iam.ServiceID, "SetDefaultPolicyVersion", handle(b.setDefaultPolicyVersion))
+	b.register(iam.ServiceID, "TagPolicy", handle(b.tagPolicy))
+	b.register(iam.ServiceID, "TagRole", handle(b.tagRole))
+	b.register(iam.ServiceID, "UntagPolicy", handle(b.untagPolicy))
+	b.register(iam.ServiceID, "UntagRole", handle(b.untagRole))
+	b.register(iam.ServiceID, "UpdateAssumeRolePolicy", handle(b.updateAssumeRolePolicy))
+	b.register(iam.ServiceID, "UpdateRole", handle(b.updateRole))
+	b.register(iam.ServiceID, "UpdateRoleDescription", handle(b.updateRoleDescription))
+}
+
+func errNoSuchEntity(format string, a ...any) error {
+	return &iamtypes.NoSuchEntityException{Message: aws.String(fmt.Sprintf(format, a...))}
+}
+
+func errDeleteConflict(format string, a ...any) error {
+	return &iamtypes.DeleteConflictException{Message: aws.String(fmt.Sprintf(format, a...))}
+}
+
+// nextEntityID returns a unique ID with the specified prefix, for example "AROA" for roles. b.mu must be held.
+func (b *Backend) nextEntityID(prefix string) string {
+	b.iam.entityID++
+
+	return fmt.Sprintf("%s%017d", prefix, b.iam.entityID)
+}
+
+// iamPath returns the path of an IAM entity, defaulting to "/".
+func iamPath(path *string) string {
+	if v := aws.ToString(path); v != "" {
+		return v
+	}
+
+	return "/"
+}
+
+// iamTags returns the tags in the order that IAM lists them.
+func iamTags(t tags) []iamtypes.Tag {
+	var apiObjects []iamtypes.Tag
+	for _, k := range t.sortedKeys() {
+		apiObjects = append(apiObjects, iamtypes.Tag{Key: aws.String(k), Value: aws.String(t[k])})
+	}
+
+	return apiObjects
+}
+
+func (t *tags) setIAM(apiObjects []iamtypes.Tag) {
+	for _, apiObject := range apiObjects {
+		t.put(aws.ToString(apiObject.Key), aws.ToString(apiObject.Value))
+	}
+}
+
+// IAM returns policy documents URL-encoded.
+func encodePolicyDocument(document string) *string {
+	return aws.String(url.QueryEscape(document))
+}
+
+// findRole returns the role with the specified name. b.mu must be held.
+func (b *Backend) findRole(name string) (*iamRole, error) {
+	role, ok := b.iam.roles[name]
+	if !ok {
+		return nil, errNoSuchEntity("The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+func (r *iamRole) apiObject() *iamtypes.Role {
+	apiObject := r.role
+	apiObject.AssumeRolePolicyDocument = encodePolicyDocument(aws.ToString(r.role.AssumeRolePolicyDocument))
+	apiObject.Tags = iamTags(r.tags)
+
+	return &apiObject
+}
+
+func (b *Backend) createRole(_ context.Context, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.RoleName)
+	if _, ok := b.iam.roles[name]; ok {
+		return nil, &iamtypes.EntityAlreadyExistsException{Message: aws.String(fmt.Sprintf("Role with name %s already exists.", name))}
+	}
+
+	path := iamPath(input.Path)
+	role := &iamRole{
+		role: iamtypes.Role{
+			Arn:                      aws.String(b.arn("iam", "", "role"+path+name)),
+			AssumeRolePolicyDocument: input.AssumeRolePolicyDocument,
+			CreateDate:               aws.Time(time.Now()),
+			Description:              input.Description,
+			MaxSessionDuration:       aws.Int32(3600),
+			Path:                     aws.String(path),
+			RoleId:                   aws.String(b.nextEntityID("AROA")),
+			RoleLastUsed:             &iamtypes.RoleLastUsed{},
+			RoleName:                 aws.String(name),
+		},
+		policies: make(map[string]string),
+	}
+	if input.MaxSessionDuration != nil {
+		role.role.MaxSessionDuration = input.MaxSessionDuration
+	}
+	if v := aws.ToString(input.PermissionsBoundary); v != "" {
+		role.role.PermissionsBoundary = &iamtypes.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn:  aws.String(v),
+			PermissionsBoundaryType: iamtypes.PermissionsBoundaryAttachmentTypePolicy,
+		}
+	}
+	role.tags.setIAM(input.Tags)
+	b.iam.roles[name] = role
+
+	return &iam.CreateRoleOutput{Role: role.apiObject()}, nil
+}
+
+func (b *Backend) getRole(_ context.Context, input *iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetRoleOutput{Role: role.apiObject()}, nil
+}
+
+func (b *Backend) updateRole(_ context.Context, input *iam.UpdateRoleInput) (*iam.UpdateRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Description != nil {
+		role.role.Description = input.Description
+	}
+	if input.MaxSessionDuration != nil {
+		role.role.MaxSessionDuration = input.MaxSessionDuration
+	}
+
+	return &iam.UpdateRoleOutput{}, nil
+}
+
+func (b *Backend) updateRoleDescription(_ context.Context, input *iam.UpdateRoleDescriptionInput) (*iam.UpdateRoleDescriptionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.Description = input.Description
+
+	return &iam.UpdateRoleDescriptionOutput{Role: role.apiObject()}, nil
+}
+
+func (b *Backend) updateAssumeRolePolicy(_ context.Context, input *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.AssumeRolePolicyDocument = input.PolicyDocument
+
+	return &iam.UpdateAssumeRolePolicyOutput{}, nil
+}
+
+func (b *Backend) deleteRole(_ context.Context, input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.RoleName)
+	role, err := b.findRole(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.policies) > 0 || len(role.attachedPolicies) > 0 {
+		return nil, errDeleteConflict("Cannot delete entity, must delete policies first.")
+	}
+
+	delete(b.iam.roles, name)
+
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (b *Backend) putRolePermissionsBoundary(_ context.Context, input *iam.PutRolePermissionsBoundaryInput) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = &iamtypes.AttachedPermissionsBoundary{
+		PermissionsBoundaryArn:  input.PermissionsBoundary,
+		PermissionsBoundaryType: iamtypes.PermissionsBoundaryAttachmentTypePolicy,
+	}
+
+	return &iam.PutRolePermissionsBoundaryOutput{}, nil
+}
+
+func (b *Backend) deleteRolePermissionsBoundary(_ context.Context, input *iam.DeleteRolePermissionsBoundaryInput) (*iam.DeleteRolePermissionsBoundaryOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.role.PermissionsBoundary = nil
+
+	return &iam.DeleteRolePermissionsBoundaryOutput{}, nil
+}
+
+func (b *Backend) putRolePolicy(_ context.Context, input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.policies[aws.ToString(input.PolicyName)] = aws.ToString(input.PolicyDocument)
+
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (b *Backend) getRolePolicy(_ context.Context, input *iam.GetRolePolicyInput) (*iam.GetRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.ToString(input.PolicyName)
+	document, ok := role.policies[name]
+	if !ok {
+		return nil, errNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	return &iam.GetRolePolicyOutput{
+		PolicyDocument: encodePolicyDocument(document),
+		PolicyName:     input.PolicyName,
+		RoleName:       input.RoleName,
+	}, nil
+}
+
+func (b *Backend) deleteRolePolicy(_ context.Context, input *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	name := aws.ToString(input.PolicyName)
+	if _, ok := role.policies[name]; !ok {
+		return nil, errNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	delete(role.policies, name)
+
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+func (b *Backend) listRolePolicies(_ context.Context, input *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListRolePoliciesOutput{PolicyNames: slices.Sorted(maps.Keys(role.policies))}, nil
+}
+
+func (b *Backend) attachRolePolicy(_ context.Context, input *iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.ToString(input.PolicyArn)
+	policy, err := b.findPolicy(arn)
+	if err != nil {
+		// AWS managed policies are not modeled and can always be attached.
+		if !strings.HasPrefix(arn, "arn:aws:iam::aws:policy/") {
+			return nil, err
+		}
+	}
+
+	if !slices.Contains(role.attachedPolicies, arn) {
+		role.attachedPolicies = append(role.attachedPolicies, arn)
+		if policy != nil {
+			policy.policy.AttachmentCount = aws.Int32(aws.ToInt32(policy.policy.AttachmentCount) + 1)
+		}
+	}
+
+	return &iam.AttachRolePolicyOutput{}, nil
+}
+
+func (b *Backend) detachRolePolicy(_ context.Context, input *iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	arn := aws.ToString(input.PolicyArn)
+	i := slices.Index(role.attachedPolicies, arn)
+	if i < 0 {
+		return nil, errNoSuchEntity("Policy %s was not found.", arn)
+	}
+
+	role.attachedPolicies = slices.Delete(role.attachedPolicies, i, i+1)
+	if policy, ok := b.iam.policies[arn]; ok {
+		policy.policy.AttachmentCount = aws.Int32(aws.ToInt32(policy.policy.AttachmentCount) - 1)
+	}
+
+	return &iam.DetachRolePolicyOutput{}, nil
+}
+
+func (b *Backend) listAttachedRolePolicies(_ context.Context, input *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjects []iamtypes.AttachedPolicy
+	for _, arn := range role.attachedPolicies {
+		apiObjects = append(apiObjects, iamtypes.AttachedPolicy{
+			PolicyArn:  aws.String(arn),
+			PolicyName: aws.String(nameFromARN(arn)),
+		})
+	}
+
+	return &iam.ListAttachedRolePoliciesOutput{AttachedPolicies: apiObjects}, nil
+}
+
+// Instance profiles are not modeled.
+func (b *Backend) listInstanceProfilesForRole(_ context.Context, input *iam.ListInstanceProfilesForRoleInput) (*iam.ListInstanceProfilesForRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.findRole(aws.ToString(input.RoleName)); err != nil {
+		return nil, err
+	}
+
+	return &iam.ListInstanceProfilesForRoleOutput{}, nil
+}
+
+func (b *Backend) listRoleTags(_ context.Context, input *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListRoleTagsOutput{Tags: iamTags(role.tags)}, nil
+}
+
+func (b *Backend) tagRole(_ context.Context, input *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.tags.setIAM(input.Tags)
+
+	return &iam.TagRoleOutput{}, nil
+}
+
+func (b *Backend) untagRole(_ context.Context, input *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	role, err := b.findRole(aws.ToString(input.RoleName))
+	if err != nil {
+		return nil, err
+	}
+
+	role.tags.remove(input.TagKeys)
+
+	return &iam.UntagRoleOutput{}, nil
+}
+
+// findPolicy returns the customer managed policy with the specified ARN. b.mu must be held.
+func (b *Backend) findPolicy(arn string) (*iamPolicy, error) {
+	policy, ok := b.iam.policies[arn]
+	if !ok {
+		return nil, errNoSuchEntity("Policy %s does not exist or is not attachable.", arn)
+	}
+
+	return policy, nil
+}
+
+func (p *iamPolicy) findVersion(versionID string) (*iamtypes.PolicyVersion, error) {
+	for i, version := range p.versions {
+		if aws.ToString(version.VersionId) == versionID {
+			return &p.versions[i], nil
+		}
+	}
+
+	return nil, errNoSuchEntity("Policy %s version %s does not exist or is not attachable.", aws.ToString(p.policy.Arn), versionID)
+}
+
+func (p *iamPolicy) setDefaultVersion(versionID string) {
+	for i := range p.versions {
+		p.versions[i].IsDefaultVersion = aws.ToString(p.versions[i].VersionId) == versionID
+	}
+	p.policy.DefaultVersionId = aws.String(versionID)
+	p.policy.UpdateDate = aws.Time(time.Now())
+}
+
+func (p *iamPolicy) apiObject() *iamtypes.Policy {
+	apiObject := p.policy
+	apiObject.Tags = iamTags(p.tags)
+
+	return &apiObject
+}
+
+func (b *Backend) createPolicy(_ context.Context, input *iam.CreatePolicyInput) (*iam.CreatePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name, path := aws.ToString(input.PolicyName), iamPath(input.Path)
+	arn := b.arn("iam", "", "policy"+path+name)
+	if _, ok := b.iam.policies[arn]; ok {
+		return nil, &iamtypes.EntityAlreadyExistsException{Message: aws.String(fmt.Sprintf("A policy called %s already exists. Duplicate names are not allowed.", name))}
+	}
+
+	now := time.Now()
+	policy := &iamPolicy{
+		policy: iamtypes.Policy{
+			Arn:              aws.String(arn),
+			AttachmentCount:  aws.Int32(0),
+			CreateDate:       aws.Time(now),
+			DefaultVersionId: aws.String("v1"),
+			IsAttachable:     true,
+			Path:             aws.String(path),
+			PolicyId:         aws.String(b.nextEntityID("ANPA")),
+			PolicyName:       aws.String(name),
+			UpdateDate:       aws.Time(now),
+		},
+		versions: []iamtypes.PolicyVersion{{
+			CreateDate:       aws.Time(now),
+			Document:         input.PolicyDocument,
+			IsDefaultVersion: true,
+			VersionId:        aws.String("v1"),
+		}},
+	}
+	if v := aws.ToString(input.Description); v != "" {
+		policy.policy.Description = aws.String(v)
+	}
+	policy.tags.setIAM(input.Tags)
+	b.iam.policies[arn] = policy
+
+	return &iam.CreatePolicyOutput{Policy: policy.apiObject()}, nil
+}
+
+func (b *Backend) getPolicy(_ context.Context, input *iam.GetPolicyInput) (*iam.GetPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.GetPolicyOutput{Policy: policy.apiObject()}, nil
+}
+
+func (b *Backend) deletePolicy(_ context.Context, input *iam.DeletePolicyInput) (*iam.DeletePolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	arn := aws.ToString(input.PolicyArn)
+	policy, err := b.findPolicy(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToInt32(policy.policy.AttachmentCount) > 0 {
+		return nil, errDeleteConflict("Cannot delete a policy attached to entities.")
+	}
+	if len(policy.versions) > 1 {
+		return nil, errDeleteConflict("This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+	}
+
+	delete(b.iam.policies, arn)
+
+	return &iam.DeletePolicyOutput{}, nil
+}
+
+func (b *Backend) createPolicyVersion(_ context.Context, input *iam.CreatePolicyVersionInput) (*iam.CreatePolicyVersionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= iamMaxPolicyVersions {
+		return nil, &iamtypes.LimitExceededException{Message: aws.String(fmt.Sprintf("A managed policy can have up to %d versions. Before you create a new version, you must delete an existing version.", iamMaxPolicyVersions))}
+	}
+
+	var latest int
+	for _, version := range policy.versions {
+		n, _ := strconv.Atoi(strings.TrimPrefix(aws.ToString(version.VersionId), "v"))
+		latest = max(latest, n)
+	}
+
+	version := iamtypes.PolicyVersion{
+		CreateDate: aws.Time(time.Now()),
+		Document:   input.PolicyDocument,
+		VersionId:  aws.String("v" + strconv.Itoa(latest+1)),
+	}
+	policy.versions = append(policy.versions, version)
+	if input.SetAsDefault {
+		policy.setDefaultVersion(aws.ToString(version.VersionId))
+		version.IsDefaultVersion = true
+	}
+
+	return &iam.CreatePolicyVersionOutput{PolicyVersion: &version}, nil
+}
+
+func (b *Backend) getPolicyVersion(_ context.Context, input *iam.GetPolicyVersionInput) (*iam.GetPolicyVersionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := policy.findVersion(aws.ToString(input.VersionId))
+	if err != nil {
+		return nil, err
+	}
+
+	apiObject := *version
+	apiObject.Document = encodePolicyDocument(aws.ToString(version.Document))
+
+	return &iam.GetPolicyVersionOutput{PolicyVersion: &apiObject}, nil
+}
+
+func (b *Backend) listPolicyVersions(_ context.Context, input *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	var apiObjects []iamtypes.PolicyVersion
+	for _, version := range slices.Backward(policy.versions) {
+		version.Document = nil
+		apiObjects = append(apiObjects, version)
+	}
+
+	return &iam.ListPolicyVersionsOutput{Versions: apiObjects}, nil
+}
+
+func (b *Backend) setDefaultPolicyVersion(_ context.Context, input *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := aws.ToString(input.VersionId)
+	if _, err := policy.findVersion(versionID); err != nil {
+		return nil, err
+	}
+
+	policy.setDefaultVersion(versionID)
+
+	return &iam.SetDefaultPolicyVersionOutput{}, nil
+}
+
+func (b *Backend) deletePolicyVersion(_ context.Context, input *iam.DeletePolicyVersionInput) (*iam.DeletePolicyVersionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := aws.ToString(input.VersionId)
+	version, err := policy.findVersion(versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.IsDefaultVersion {
+		return nil, errDeleteConflict("Cannot delete the default version of a policy.")
+	}
+
+	policy.versions = slices.DeleteFunc(policy.versions, func(v iamtypes.PolicyVersion) bool {
+		return aws.ToString(v.VersionId) == versionID
+	})
+
+	return &iam.DeletePolicyVersionOutput{}, nil
+}
+
+func (b *Backend) listPolicyTags(_ context.Context, input *iam.ListPolicyTagsInput) (*iam.ListPolicyTagsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iam.ListPolicyTagsOutput{Tags: iamTags(policy.tags)}, nil
+}
+
+func (b *Backend) tagPolicy(_ context.Context, input *iam.TagPolicyInput) (*iam.TagPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.tags.setIAM(input.Tags)
+
+	return &iam.TagPolicyOutput{}, nil
+}
+
+func (b *Backend) untagPolicy(_ context.Context, input *iam.UntagPolicyInput) (*iam.UntagPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	policy, err := b.findPolicy(aws.ToString(input.PolicyArn))
+	if err != nil {
+		return nil, err
+	}
+
+	policy.tags.remove(input.TagKeys)
+
+	return &iam.UntagPolicyOutput{}, nil
+}
@@ -0,0 +1,458 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/mockaws"
+)
+
+func newConfig(b *mockaws.Backend) aws.Config {
+	return aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider(mockaws.AccessKeyID, mockaws.SecretAccessKey, ""),
+		HTTPClient:  b.HTTPClient(),
+		Region:      b.Region(),
+		APIOptions:  []func(*middleware.Stack) error{b.Middleware()},
+	}
+}
+
+func errCode(err error) string {
+	if apiErr, ok := errors.AsType[smithy.APIError](err); ok {
+		return apiErr.ErrorCode()
+	}
+
+	return ""
+}
+
+func TestCallerIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend(mockaws.WithAccountID("111122223333"))
+
+	// Served by the middleware.
+	output, err := sts.NewFromConfig(newConfig(b)).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(output.Account), "111122223333"; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+
+	// Served by the HTTP client.
+	cfg := newConfig(b)
+	cfg.APIOptions = nil
+	output, err = sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(output.Arn), "arn:aws:iam::111122223333:user/mockaws"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+
+	_, err := sqs.NewFromConfig(newConfig(b)).PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/q")})
+	if err == nil || !strings.Contains(err.Error(), "SQS PurgeQueue is not implemented") {
+		t.Errorf("PurgeQueue error = %v", err)
+	}
+
+	// Requests that reach the HTTP client fail without making a network connection.
+	cfg := newConfig(b)
+	cfg.APIOptions = nil
+	_, err = sqs.NewFromConfig(cfg).ListQueues(ctx, &sqs.ListQueuesInput{})
+	if err == nil || !strings.Contains(err.Error(), "501") {
+		t.Errorf("ListQueues error = %v", err)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := sqs.NewFromConfig(newConfig(b))
+
+	output, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String("test"),
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNameDelaySeconds): "90"},
+		Tags:       map[string]string{"key1": "value1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queueURL := aws.ToString(output.QueueUrl)
+	if got, want := queueURL, "https://sqs.us-west-2.amazonaws.com/123456789012/test"; got != want {
+		t.Errorf("QueueUrl = %q, want %q", got, want)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[sqstypes.QueueAttributeName]string{
+		sqstypes.QueueAttributeNameDelaySeconds:      "90",
+		sqstypes.QueueAttributeNameQueueArn:          "arn:aws:sqs:us-west-2:123456789012:test",
+		sqstypes.QueueAttributeNameVisibilityTimeout: "30",
+	} {
+		if got := attributes.Attributes[string(k)]; got != want {
+			t.Errorf("%s = %q, want %q", k, got, want)
+		}
+	}
+
+	if _, err := conn.UntagQueue(ctx, &sqs.UntagQueueInput{QueueUrl: aws.String(queueURL), TagKeys: []string{"key1"}}); err != nil {
+		t.Fatal(err)
+	}
+	tags, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: aws.String(queueURL)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags.Tags) != 0 {
+		t.Errorf("Tags = %v, want none", tags.Tags)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+	if got, want := errCode(err), "AWS.SimpleQueueService.NonExistentQueue"; got != want {
+		t.Errorf("error code = %q, want %q", got, want)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := sns.NewFromConfig(newConfig(b))
+
+	output, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{Name: aws.String("test")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	topicARN := output.TopicArn
+
+	if _, err := conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       topicARN,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: topicARN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := attributes.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: topicARN}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: topicARN})
+	if _, ok := errors.AsType[*snstypes.NotFoundException](err); !ok {
+		t.Errorf("GetTopicAttributes error = %v, want NotFoundException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := ssm.NewFromConfig(newConfig(b))
+
+	for _, overwrite := range []bool{false, true} {
+		if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:      aws.String("/test/param"),
+			Overwrite: aws.Bool(overwrite),
+			Type:      ssmtypes.ParameterTypeSecureString,
+			Value:     aws.String("secret"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/test/param"), WithDecryption: aws.Bool(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(output.Parameter.Value), "secret"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := output.Parameter.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(output.Parameter.ARN), "arn:aws:ssm:us-west-2:123456789012:parameter/test/param"; got != want {
+		t.Errorf("ARN = %q, want %q", got, want)
+	}
+
+	metadata, err := conn.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: []string{"/test/param"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(metadata.Parameters), 1; got != want {
+		t.Fatalf("len(Parameters) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(metadata.Parameters[0].KeyId), "alias/aws/ssm"; got != want {
+		t.Errorf("KeyId = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String("/test/param")}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/test/param")})
+	if _, ok := errors.AsType[*ssmtypes.ParameterNotFound](err); !ok {
+		t.Errorf("GetParameter error = %v, want ParameterNotFound", err)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := iam.NewFromConfig(newConfig(b))
+
+	const document = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	role, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(document),
+		RoleName:                 aws.String("test"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(role.Role.Arn), "arn:aws:iam::123456789012:role/test"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	policy, err := conn.CreatePolicy(ctx, &iam.CreatePolicyInput{
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`),
+		PolicyName:     aws.String("test"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policyARN := policy.Policy.Arn
+
+	if _, err := conn.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{PolicyArn: policyARN, RoleName: aws.String("test")}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")})
+	if _, ok := errors.AsType[*iamtypes.DeleteConflictException](err); !ok {
+		t.Errorf("DeleteRole error = %v, want DeleteConflictException", err)
+	}
+
+	if _, err := conn.CreatePolicyVersion(ctx, &iam.CreatePolicyVersionInput{
+		PolicyArn:      policyARN,
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`),
+		SetAsDefault:   true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := conn.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{PolicyArn: policyARN, VersionId: aws.String("v2")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !version.PolicyVersion.IsDefaultVersion {
+		t.Error("IsDefaultVersion = false, want true")
+	}
+	if got, err := url.QueryUnescape(aws.ToString(version.PolicyVersion.Document)); err != nil || !strings.Contains(got, "Deny") {
+		t.Errorf("Document = %q, %v", got, err)
+	}
+
+	getRole, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := url.QueryUnescape(aws.ToString(getRole.Role.AssumeRolePolicyDocument)); err != nil || got != document {
+		t.Errorf("AssumeRolePolicyDocument = %q, %v", got, err)
+	}
+
+	if _, err := conn.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{PolicyArn: policyARN, RoleName: aws.String("test")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if _, ok := errors.AsType[*iamtypes.NoSuchEntityException](err); !ok {
+		t.Errorf("GetRole error = %v, want NoSuchEntityException", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := dynamodb.NewFromConfig(newConfig(b))
+
+	output, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{{AttributeName: aws.String("pk"), AttributeType: dynamodbtypes.ScalarAttributeTypeS}},
+		BillingMode:          dynamodbtypes.BillingModePayPerRequest,
+		KeySchema:            []dynamodbtypes.KeySchemaElement{{AttributeName: aws.String("pk"), KeyType: dynamodbtypes.KeyTypeHash}},
+		TableName:            aws.String("test"),
+		Tags:                 []dynamodbtypes.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableARN := output.TableDescription.TableArn
+
+	table, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String("test")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+
+	if _, err := conn.UpdateTimeToLive(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName:               aws.String("test"),
+		TimeToLiveSpecification: &dynamodbtypes.TimeToLiveSpecification{AttributeName: aws.String("expires"), Enabled: aws.Bool(true)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ttl, err := conn.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String("test")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ttl.TimeToLiveDescription.TimeToLiveStatus, dynamodbtypes.TimeToLiveStatusEnabled; got != want {
+		t.Errorf("TimeToLiveStatus = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsOfResource(ctx, &dynamodb.ListTagsOfResourceInput{ResourceArn: tableARN})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tags.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String("test")})
+	if _, ok := errors.AsType[*dynamodbtypes.ResourceNotFoundException](err); !ok {
+		t.Errorf("DescribeTable error = %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := mockaws.NewBackend()
+	conn := s3.NewFromConfig(newConfig(b))
+
+	if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String("test-bucket"),
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+			Tags:               []s3types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Served by the HTTP client.
+	head, err := conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String("test-bucket")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(head.BucketRegion), "us-west-2"; got != want {
+		t.Errorf("BucketRegion = %q, want %q", got, want)
+	}
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String("no-such-bucket")})
+	if _, ok := errors.AsType[*s3types.NotFound](err); !ok {
+		t.Errorf("HeadBucket error = %v, want NotFound", err)
+	}
+
+	_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String("test-bucket")})
+	if got, want := errCode(err), "NoSuchBucketPolicy"; got != want {
+		t.Errorf("error code = %q, want %q", got, want)
+	}
+
+	if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:    strings.NewReader("hello"),
+		Bucket:  aws.String("test-bucket"),
+		Key:     aws.String("key"),
+		Tagging: aws.String("a=b&c=d%20e"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	object, err := conn.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String("test-bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aws.ToString(object.ETag), `"5d41402abc4b2a76b9719d911017c592"`; got != want {
+		t.Errorf("ETag = %q, want %q", got, want)
+	}
+
+	tagging, err := conn.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{Bucket: aws.String("test-bucket"), Key: aws.String("key")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(tagging.TagSet), 2; got != want {
+		t.Fatalf("len(TagSet) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToString(tagging.TagSet[1].Value), "d e"; got != want {
+		t.Errorf("TagSet[1].Value = %q, want %q", got, want)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String("test-bucket")})
+	if got, want := errCode(err), "BucketNotEmpty"; got != want {
+		t.Errorf("error code = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String("test-bucket"), Key: aws.String("key")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String("test-bucket")}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,1028 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"crypto/md5" // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-md5 -- S3 ETags are MD5 digests
+	"encoding/hex"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+type s3State struct {
+	// buckets are keyed by bucket name.
+	buckets map[string]*s3Bucket
+}
+
+type s3Bucket struct {
+	createdAt               time.Time
+	accelerateStatus        s3types.BucketAccelerateStatus
+	corsRules               []s3types.CORSRule
+	encryption              *s3types.ServerSideEncryptionConfiguration
+	lifecycleRules          []s3types.LifecycleRule
+	logging                 *s3types.LoggingEnabled
+	objectLockConfiguration *s3types.ObjectLockConfiguration
+	payer                   s3types.Payer
+	policy                  string
+	replication             *s3types.ReplicationConfiguration
+	versioning              s3types.BucketVersioningStatus
+	website                 *s3.GetBucketWebsiteOutput
+	tags                    tags
+	// objects are keyed by object key. Only the current version of each object is kept.
+	objects map[string]*s3Object
+	version int
+}
+
+type s3Object struct {
+	body         []byte
+	contentType  string
+	lastModified time.Time
+	metadata     map[string]string
+	versionID    string
+	tags         tags
+}
+
+func (b *Backend) registerS3() {
+	b.s3.buckets = make(map[string]*s3Bucket)
+
+	b.register(s3.ServiceID, "CreateBucket", handle(b.createBucket))
+	b.register(s3.ServiceID, "DeleteBucket", handle(b.deleteBucket))
+	b.register(s3.ServiceID, "DeleteBucketCors", handle(b.deleteBucketCors))
+	b.register(s3.ServiceID, "DeleteBucketEncryption", handle(b.deleteBucketEncryption))
+	b.register(s3.ServiceID, "DeleteBucketLifecycle", handle(b.deleteBucketLifecycle))
+	b.register(s3.ServiceID, "DeleteBucketPolicy", handle(b.deleteBucketPolicy))
+	b.register(s3.ServiceID, "DeleteBucketReplication", handle(b.deleteBucketReplication))
+	b.register(s3.ServiceID, "DeleteBucketTagging", handle(b.deleteBucketTagging))
+	b.register(s3.ServiceID, "DeleteBucketWebsite", handle(b.deleteBucketWebsite))
+	b.register(s3.ServiceID, "DeleteObject", handle(b.deleteObject))
+	b.register(s3.ServiceID, "DeleteObjects", handle(b.deleteObjects))
+	b.register(s3.ServiceID, "DeleteObjectTagging", handle(b.deleteObjectTagging))
+	b.register(s3.ServiceID, "GetBucketAccelerateConfiguration", handle(b.getBucketAccelerateConfiguration))
+	b.register(s3.ServiceID, "GetBucketAcl", handle(b.getBucketACL))
+	b.register(s3.ServiceID, "GetBucketCors", handle(b.getBucketCors))
+	b.register(s3.ServiceID, "GetBucketEncryption", handle(b.getBucketEncryption))
+	b.register(s3.ServiceID, "GetBucketLifecycleConfiguration", handle(b.getBucketLifecycleConfiguration))
+	b.register(s3.ServiceID, "GetBucketLogging", handle(b.getBucketLogging))
+	b.register(s3.ServiceID, "GetBucketPolicy", handle(b.getBucketPolicy))
+	b.register(s3.ServiceID, "GetBucketReplication", handle(b.getBucketReplication))
+	b.register(s3.ServiceID, "GetBucketRequestPayment", handle(b.getBucketRequestPayment))
+	b.register(s3.ServiceID, "GetBucketTagging", handle(b.getBucketTagging))
+	b.register(s3.ServiceID, "GetBucketVersioning", handle(b.getBucketVersioning))
+	b.register(s3.ServiceID, "GetBucketWebsite", handle(b.getBucketWebsite))
+	b.register(s3.ServiceID, "GetObject", handle(b.getObject))
+	b.register(s3.ServiceID, "GetObjectLockConfiguration", handle(b.getObjectLockConfiguration))
+	b.register(s3.ServiceID, "GetObjectTagging", handle(b.getObjectTagging))
+	b.register(s3.ServiceID, "HeadObject", handle(b.headObject))
+	b.register(s3.ServiceID, "ListBuckets", handle(b.listBuckets))
+	b.register(s3.ServiceID, "ListObjectVersions", handle(b.listObjectVersions))
+	b.register(s3.ServiceID, "ListObjectsV2", handle(b.listObjectsV2))
+	b.register(s3.ServiceID, "PutBucketAccelerateConfiguration", handle(b.putBucketAccelerateConfiguration))
+	b.register(s3.ServiceID, "PutBucketAcl", handle(b.putBucketACL))
+	b.register(s3.ServiceID, "PutBucketCors", handle(b.putBucketCors))
+	b.register(s3.ServiceID, "PutBucketEncryption", handle(b.putBucketEncryption))
+	b.register(s3.ServiceID, "PutBucketLifecycleConfiguration", handle(b.putBucketLifecycleConfiguration))
+	b.register(s3.ServiceID, "PutBucketLogging", handle(b.putBucketLogging))
+	b.register(s3.ServiceID, "PutBucketPolicy", handle(b.putBucketPolicy))
+	b.register(s3.ServiceID, "PutBucketReplication", handle(b.putBucketReplication))
+	b.register(s3.ServiceID, "PutBucketRequestPayment", handle(b.putBucketRequestPayment))
+	b.register(s3.ServiceID, "PutBucketTagging", handle(b.putBucketTagging))
+	b.register(s3.ServiceID, "PutBucketVersioning", handle(b.putBucketVersioning))
+	b.register(s3.ServiceID, "PutBucketWebsite", handle(b.putBucketWebsite))
+	b.register(s3.ServiceID, "PutObject", handle(b.putObject))
+	b.register(s3.ServiceID, "PutObjectLockConfiguration", handle(b.putObjectLockConfiguration))
+	b.register(s3.ServiceID, "PutObjectTagging", handle(b.putObjectTagging))
+}
+
+// errS3 returns an S3 error with the specified code.
+// Most S3 "configuration not found" errors are not modeled.
+func errS3(code, message string) error {
+	return &smithy.GenericAPIError{Code: code, Message: message}
+}
+
+// errS3NotFound returns the error that S3 returns for HEAD requests of objects that do not exist.
+func errS3NotFound() error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			Err:      &s3types.NotFound{Message: aws.String("Not Found")},
+		},
+	}
+}
+
+// findBucket returns the bucket with the specified name. b.mu must be held.
+func (b *Backend) findBucket(name *string) (*s3Bucket, error) {
+	bucket, ok := b.s3.buckets[aws.ToString(name)]
+	if !ok {
+		return nil, &s3types.NoSuchBucket{Message: aws.String("The specified bucket does not exist")}
+	}
+
+	return bucket, nil
+}
+
+// defaultBucketEncryption returns the encryption configuration of new buckets, SSE-S3.
+func defaultBucketEncryption() *s3types.ServerSideEncryptionConfiguration {
+	return &s3types.ServerSideEncryptionConfiguration{
+		Rules: []s3types.ServerSideEncryptionRule{{
+			ApplyServerSideEncryptionByDefault: &s3types.ServerSideEncryptionByDefault{
+				SSEAlgorithm: s3types.ServerSideEncryptionAes256,
+			},
+			BucketKeyEnabled: aws.Bool(false),
+		}},
+	}
+}
+
+// canonicalUserID returns the account's S3 canonical user ID.
+func (b *Backend) canonicalUserID() string {
+	return strings.Repeat("0", 64-len(b.accountID)) + b.accountID
+}
+
+func (b *Backend) createBucket(_ context.Context, input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.Bucket)
+	if _, ok := b.s3.buckets[name]; ok {
+		return nil, &s3types.BucketAlreadyOwnedByYou{Message: aws.String("Your previous request to create the named bucket succeeded and you already own it.")}
+	}
+
+	bucket := &s3Bucket{
+		createdAt:  time.Now(),
+		encryption: defaultBucketEncryption(),
+		objects:    make(map[string]*s3Object),
+		payer:      s3types.PayerBucketOwner,
+	}
+	if aws.ToBool(input.ObjectLockEnabledForBucket) {
+		bucket.objectLockConfiguration = &s3types.ObjectLockConfiguration{
+			ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		}
+		bucket.versioning = s3types.BucketVersioningStatusEnabled
+	}
+	if v := input.CreateBucketConfiguration; v != nil {
+		for _, tag := range v.Tags {
+			bucket.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+		}
+	}
+	b.s3.buckets[name] = bucket
+
+	return &s3.CreateBucketOutput{
+		BucketArn: aws.String(b.arn("s3", "", name)),
+		Location:  aws.String("/" + name),
+	}, nil
+}
+
+func (b *Backend) deleteBucket(_ context.Context, input *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.objects) > 0 {
+		return nil, errS3("BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(b.s3.buckets, aws.ToString(input.Bucket))
+
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+func (b *Backend) listBuckets(_ context.Context, _ *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var apiObjects []s3types.Bucket
+	for _, name := range slices.Sorted(maps.Keys(b.s3.buckets)) {
+		apiObjects = append(apiObjects, s3types.Bucket{
+			BucketArn:    aws.String(b.arn("s3", "", name)),
+			BucketRegion: aws.String(b.region),
+			CreationDate: aws.Time(b.s3.buckets[name].createdAt),
+			Name:         aws.String(name),
+		})
+	}
+
+	return &s3.ListBucketsOutput{Buckets: apiObjects}, nil
+}
+
+func (b *Backend) getBucketAccelerateConfiguration(_ context.Context, input *s3.GetBucketAccelerateConfigurationInput) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketAccelerateConfigurationOutput{Status: bucket.accelerateStatus}, nil
+}
+
+func (b *Backend) putBucketAccelerateConfiguration(_ context.Context, input *s3.PutBucketAccelerateConfigurationInput) (*s3.PutBucketAccelerateConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.AccelerateConfiguration; v != nil {
+		bucket.accelerateStatus = v.Status
+	}
+
+	return &s3.PutBucketAccelerateConfigurationOutput{}, nil
+}
+
+// ACLs are not modeled. Buckets are private and owned by the backend's account.
+func (b *Backend) getBucketACL(_ context.Context, input *s3.GetBucketAclInput) (*s3.GetBucketAclOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.findBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+
+	owner := &s3types.Owner{ID: aws.String(b.canonicalUserID())}
+
+	return &s3.GetBucketAclOutput{
+		Grants: []s3types.Grant{{
+			Grantee: &s3types.Grantee{
+				ID:   owner.ID,
+				Type: s3types.TypeCanonicalUser,
+			},
+			Permission: s3types.PermissionFullControl,
+		}},
+		Owner: owner,
+	}, nil
+}
+
+func (b *Backend) putBucketACL(_ context.Context, input *s3.PutBucketAclInput) (*s3.PutBucketAclOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.findBucket(input.Bucket); err != nil {
+		return nil, err
+	}
+
+	return &s3.PutBucketAclOutput{}, nil
+}
+
+func (b *Backend) getBucketCors(_ context.Context, input *s3.GetBucketCorsInput) (*s3.GetBucketCorsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.corsRules) == 0 {
+		return nil, errS3("NoSuchCORSConfiguration", "The CORS configuration does not exist")
+	}
+
+	return &s3.GetBucketCorsOutput{CORSRules: bucket.corsRules}, nil
+}
+
+func (b *Backend) putBucketCors(_ context.Context, input *s3.PutBucketCorsInput) (*s3.PutBucketCorsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.CORSConfiguration; v != nil {
+		bucket.corsRules = v.CORSRules
+	}
+
+	return &s3.PutBucketCorsOutput{}, nil
+}
+
+func (b *Backend) deleteBucketCors(_ context.Context, input *s3.DeleteBucketCorsInput) (*s3.DeleteBucketCorsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.corsRules = nil
+
+	return &s3.DeleteBucketCorsOutput{}, nil
+}
+
+func (b *Backend) getBucketEncryption(_ context.Context, input *s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketEncryptionOutput{ServerSideEncryptionConfiguration: bucket.encryption}, nil
+}
+
+func (b *Backend) putBucketEncryption(_ context.Context, input *s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.encryption = input.ServerSideEncryptionConfiguration
+
+	return &s3.PutBucketEncryptionOutput{}, nil
+}
+
+// Deleting a bucket's encryption configuration reverts it to the default.
+func (b *Backend) deleteBucketEncryption(_ context.Context, input *s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.encryption = defaultBucketEncryption()
+
+	return &s3.DeleteBucketEncryptionOutput{}, nil
+}
+
+func (b *Backend) getBucketLifecycleConfiguration(_ context.Context, input *s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.lifecycleRules) == 0 {
+		return nil, errS3("NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+	}
+
+	return &s3.GetBucketLifecycleConfigurationOutput{
+		Rules:                              bucket.lifecycleRules,
+		TransitionDefaultMinimumObjectSize: s3types.TransitionDefaultMinimumObjectSizeAllStorageClasses128k,
+	}, nil
+}
+
+func (b *Backend) putBucketLifecycleConfiguration(_ context.Context, input *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.LifecycleConfiguration; v != nil {
+		bucket.lifecycleRules = v.Rules
+	}
+
+	return &s3.PutBucketLifecycleConfigurationOutput{
+		TransitionDefaultMinimumObjectSize: s3types.TransitionDefaultMinimumObjectSizeAllStorageClasses128k,
+	}, nil
+}
+
+func (b *Backend) deleteBucketLifecycle(_ context.Context, input *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.lifecycleRules = nil
+
+	return &s3.DeleteBucketLifecycleOutput{}, nil
+}
+
+func (b *Backend) getBucketLogging(_ context.Context, input *s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketLoggingOutput{LoggingEnabled: bucket.logging}, nil
+}
+
+func (b *Backend) putBucketLogging(_ context.Context, input *s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.BucketLoggingStatus; v != nil {
+		bucket.logging = v.LoggingEnabled
+	}
+
+	return &s3.PutBucketLoggingOutput{}, nil
+}
+
+func (b *Backend) getBucketPolicy(_ context.Context, input *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.policy == "" {
+		return nil, errS3("NoSuchBucketPolicy", "The bucket policy does not exist")
+	}
+
+	return &s3.GetBucketPolicyOutput{Policy: aws.String(bucket.policy)}, nil
+}
+
+func (b *Backend) putBucketPolicy(_ context.Context, input *s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.policy = aws.ToString(input.Policy)
+
+	return &s3.PutBucketPolicyOutput{}, nil
+}
+
+func (b *Backend) deleteBucketPolicy(_ context.Context, input *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.policy = ""
+
+	return &s3.DeleteBucketPolicyOutput{}, nil
+}
+
+func (b *Backend) getBucketReplication(_ context.Context, input *s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.replication == nil {
+		return nil, errS3("ReplicationConfigurationNotFoundError", "The replication configuration was not found")
+	}
+
+	return &s3.GetBucketReplicationOutput{ReplicationConfiguration: bucket.replication}, nil
+}
+
+func (b *Backend) putBucketReplication(_ context.Context, input *s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.versioning != s3types.BucketVersioningStatusEnabled {
+		return nil, errS3("InvalidRequest", "Versioning must be 'Enabled' on the bucket to apply a replication configuration")
+	}
+
+	bucket.replication = input.ReplicationConfiguration
+
+	return &s3.PutBucketReplicationOutput{}, nil
+}
+
+func (b *Backend) deleteBucketReplication(_ context.Context, input *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.replication = nil
+
+	return &s3.DeleteBucketReplicationOutput{}, nil
+}
+
+func (b *Backend) getBucketRequestPayment(_ context.Context, input *s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketRequestPaymentOutput{Payer: bucket.payer}, nil
+}
+
+func (b *Backend) putBucketRequestPayment(_ context.Context, input *s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.RequestPaymentConfiguration; v != nil {
+		bucket.payer = v.Payer
+	}
+
+	return &s3.PutBucketRequestPaymentOutput{}, nil
+}
+
+func (b *Backend) getBucketTagging(_ context.Context, input *s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.tags) == 0 {
+		return nil, errS3("NoSuchTagSet", "The TagSet does not exist")
+	}
+
+	return &s3.GetBucketTaggingOutput{TagSet: s3Tags(bucket.tags)}, nil
+}
+
+func (b *Backend) putBucketTagging(_ context.Context, input *s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// PutBucketTagging replaces the tag set.
+	bucket.tags = nil
+	if v := input.Tagging; v != nil {
+		for _, tag := range v.TagSet {
+			bucket.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+		}
+	}
+
+	return &s3.PutBucketTaggingOutput{}, nil
+}
+
+func (b *Backend) deleteBucketTagging(_ context.Context, input *s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.tags = nil
+
+	return &s3.DeleteBucketTaggingOutput{}, nil
+}
+
+func (b *Backend) getBucketVersioning(_ context.Context, input *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetBucketVersioningOutput{Status: bucket.versioning}, nil
+}
+
+func (b *Backend) putBucketVersioning(_ context.Context, input *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.VersioningConfiguration; v != nil {
+		if bucket.objectLockConfiguration != nil && v.Status != s3types.BucketVersioningStatusEnabled {
+			return nil, errS3("InvalidBucketState", "An Object Lock configuration is present on this bucket, so the versioning state cannot be changed.")
+		}
+		bucket.versioning = v.Status
+	}
+
+	return &s3.PutBucketVersioningOutput{}, nil
+}
+
+func (b *Backend) getBucketWebsite(_ context.Context, input *s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.website == nil {
+		return nil, errS3("NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration")
+	}
+
+	output := *bucket.website
+
+	return &output, nil
+}
+
+func (b *Backend) putBucketWebsite(_ context.Context, input *s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.WebsiteConfiguration; v != nil {
+		bucket.website = &s3.GetBucketWebsiteOutput{
+			ErrorDocument:         v.ErrorDocument,
+			IndexDocument:         v.IndexDocument,
+			RedirectAllRequestsTo: v.RedirectAllRequestsTo,
+			RoutingRules:          v.RoutingRules,
+		}
+	}
+
+	return &s3.PutBucketWebsiteOutput{}, nil
+}
+
+func (b *Backend) deleteBucketWebsite(_ context.Context, input *s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.website = nil
+
+	return &s3.DeleteBucketWebsiteOutput{}, nil
+}
+
+func (b *Backend) getObjectLockConfiguration(_ context.Context, input *s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.objectLockConfiguration == nil {
+		return nil, errS3("ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket")
+	}
+
+	return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: bucket.objectLockConfiguration}, nil
+}
+
+func (b *Backend) putObjectLockConfiguration(_ context.Context, input *s3.PutObjectLockConfigurationInput) (*s3.PutObjectLockConfigurationOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if bucket.versioning != s3types.BucketVersioningStatusEnabled {
+		return nil, errS3("InvalidBucketState", "Versioning must be 'Enabled' on the bucket to apply a Object Lock configuration")
+	}
+
+	bucket.objectLockConfiguration = input.ObjectLockConfiguration
+
+	return &s3.PutObjectLockConfigurationOutput{}, nil
+}
+
+func s3Tags(t tags) []s3types.Tag {
+	var apiObjects []s3types.Tag
+	for _, k := range t.sortedKeys() {
+		apiObjects = append(apiObjects, s3types.Tag{Key: aws.String(k), Value: aws.String(t[k])})
+	}
+
+	return apiObjects
+}
+
+// parseObjectTagging parses the URL-encoded tag set of a PutObject request.
+func parseObjectTagging(tagging string) (tags, error) {
+	values, err := url.ParseQuery(tagging)
+	if err != nil {
+		return nil, &smithy.GenericAPIError{Code: "InvalidArgument", Message: "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates."}
+	}
+
+	var t tags
+	for k, v := range values {
+		t.put(k, v[0])
+	}
+
+	return t, nil
+}
+
+func (o *s3Object) etag() string {
+	sum := md5.Sum(o.body) // nosemgrep:go.lang.security.audit.crypto.use_of_weak_crypto.use-of-md5
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// findObject returns the object with the specified key. b.mu must be held.
+func (b *Backend) findObject(bucketName, key *string) (*s3Bucket, *s3Object, error) {
+	bucket, err := b.findBucket(bucketName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	object, ok := bucket.objects[aws.ToString(key)]
+	if !ok {
+		return bucket, nil, &s3types.NoSuchKey{Message: aws.String("The specified key does not exist.")}
+	}
+
+	return bucket, object, nil
+}
+
+func (b *Backend) putObject(_ context.Context, input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	var body []byte
+	if input.Body != nil {
+		var err error
+		if body, err = io.ReadAll(input.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := parseObjectTagging(aws.ToString(input.Tagging))
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := "null"
+	if bucket.versioning == s3types.BucketVersioningStatusEnabled {
+		bucket.version++
+		versionID = strconv.Itoa(bucket.version)
+	}
+
+	object := &s3Object{
+		body:         body,
+		contentType:  aws.ToString(input.ContentType),
+		lastModified: time.Now(),
+		metadata:     input.Metadata,
+		tags:         tags,
+		versionID:    versionID,
+	}
+	if object.contentType == "" {
+		object.contentType = "binary/octet-stream"
+	}
+	bucket.objects[aws.ToString(input.Key)] = object
+
+	output := &s3.PutObjectOutput{
+		ETag:                 aws.String(object.etag()),
+		ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+	}
+	if versionID != "null" {
+		output.VersionId = aws.String(versionID)
+	}
+
+	return output, nil
+}
+
+func (b *Backend) headObject(_ context.Context, input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, object, err := b.findObject(input.Bucket, input.Key)
+	if err != nil {
+		return nil, errS3NotFound()
+	}
+
+	output := &s3.HeadObjectOutput{
+		ContentLength:        aws.Int64(int64(len(object.body))),
+		ContentType:          aws.String(object.contentType),
+		ETag:                 aws.String(object.etag()),
+		LastModified:         aws.Time(object.lastModified),
+		Metadata:             maps.Clone(object.metadata),
+		ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+		StorageClass:         s3types.StorageClassStandard,
+	}
+	if bucket.versioning != "" {
+		output.VersionId = aws.String(object.versionID)
+	}
+
+	return output, nil
+}
+
+func (b *Backend) getObject(_ context.Context, input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, object, err := b.findObject(input.Bucket, input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectOutput{
+		Body:          io.NopCloser(strings.NewReader(string(object.body))),
+		ContentLength: aws.Int64(int64(len(object.body))),
+		ContentType:   aws.String(object.contentType),
+		ETag:          aws.String(object.etag()),
+		LastModified:  aws.Time(object.lastModified),
+		Metadata:      maps.Clone(object.metadata),
+		TagCount:      aws.Int32(int32(len(object.tags))),
+	}, nil
+}
+
+func (b *Backend) deleteObject(_ context.Context, input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting an object that does not exist succeeds.
+	delete(bucket.objects, aws.ToString(input.Key))
+
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func (b *Backend) deleteObjects(_ context.Context, input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []s3types.DeletedObject
+	if v := input.Delete; v != nil {
+		for _, apiObject := range v.Objects {
+			delete(bucket.objects, aws.ToString(apiObject.Key))
+			deleted = append(deleted, s3types.DeletedObject{Key: apiObject.Key, VersionId: apiObject.VersionId})
+		}
+	}
+
+	return &s3.DeleteObjectsOutput{Deleted: deleted}, nil
+}
+
+func (b *Backend) listObjectVersions(_ context.Context, input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []s3types.ObjectVersion
+	for _, key := range slices.Sorted(maps.Keys(bucket.objects)) {
+		if !strings.HasPrefix(key, aws.ToString(input.Prefix)) {
+			continue
+		}
+
+		object := bucket.objects[key]
+		versions = append(versions, s3types.ObjectVersion{
+			ETag:         aws.String(object.etag()),
+			IsLatest:     aws.Bool(true),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: s3types.ObjectVersionStorageClassStandard,
+			VersionId:    aws.String(object.versionID),
+		})
+	}
+
+	return &s3.ListObjectVersionsOutput{
+		IsTruncated: aws.Bool(false),
+		Name:        input.Bucket,
+		Prefix:      input.Prefix,
+		Versions:    versions,
+	}, nil
+}
+
+func (b *Backend) listObjectsV2(_ context.Context, input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, err := b.findBucket(input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var contents []s3types.Object
+	for _, key := range slices.Sorted(maps.Keys(bucket.objects)) {
+		if !strings.HasPrefix(key, aws.ToString(input.Prefix)) {
+			continue
+		}
+
+		object := bucket.objects[key]
+		contents = append(contents, s3types.Object{
+			ETag:         aws.String(object.etag()),
+			Key:          aws.String(key),
+			LastModified: aws.Time(object.lastModified),
+			Size:         aws.Int64(int64(len(object.body))),
+			StorageClass: s3types.ObjectStorageClassStandard,
+		})
+	}
+
+	return &s3.ListObjectsV2Output{
+		Contents:    contents,
+		IsTruncated: aws.Bool(false),
+		KeyCount:    aws.Int32(int32(len(contents))),
+		Name:        input.Bucket,
+		Prefix:      input.Prefix,
+	}, nil
+}
+
+func (b *Backend) getObjectTagging(_ context.Context, input *s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, object, err := b.findObject(input.Bucket, input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3.GetObjectTaggingOutput{TagSet: s3Tags(object.tags)}, nil
+}
+
+func (b *Backend) putObjectTagging(_ context.Context, input *s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, object, err := b.findObject(input.Bucket, input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+	if v := input.Tagging; v != nil {
+		for _, tag := range v.TagSet {
+			object.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+		}
+	}
+
+	return &s3.PutObjectTaggingOutput{}, nil
+}
+
+func (b *Backend) deleteObjectTagging(_ context.Context, input *s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, object, err := b.findObject(input.Bucket, input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	object.tags = nil
+
+	return &s3.DeleteObjectTaggingOutput{}, nil
+}
@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+)
+
+type snsState struct {
+	// topics are keyed by topic ARN.
+	topics map[string]*snsTopic
+}
+
+type snsTopic struct {
+	attributes map[string]string
+	tags       tags
+}
+
+func (b *Backend) registerSNS() {
+	b.sns.topics = make(map[string]*snsTopic)
+
+	b.register(sns.ServiceID, "CreateTopic", handle(b.createTopic))
+	b.register(sns.ServiceID, "DeleteTopic", handle(b.deleteTopic))
+	b.register(sns.ServiceID, "GetTopicAttributes", handle(b.getTopicAttributes))
+	b.register(sns.ServiceID, "ListTagsForResource", handle(b.listSNSTagsForResource))
+	b.register(sns.ServiceID, "ListTopics", handle(b.listTopics))
+	b.register(sns.ServiceID, "SetTopicAttributes", handle(b.setTopicAttributes))
+	b.register(sns.ServiceID, "TagResource", handle(b.tagSNSResource))
+	b.register(sns.ServiceID, "UntagResource", handle(b.untagSNSResource))
+}
+
+func errSNSNotFound() error {
+	return &snstypes.NotFoundException{Message: aws.String("Topic does not exist")}
+}
+
+// findTopic returns the topic with the specified ARN. b.mu must be held.
+func (b *Backend) findTopic(arn string) (*snsTopic, error) {
+	topic, ok := b.sns.topics[arn]
+	if !ok {
+		return nil, errSNSNotFound()
+	}
+
+	return topic, nil
+}
+
+// defaultTopicPolicy returns the access policy that SNS attaches to a new topic.
+func (b *Backend) defaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"%s","Condition":{"StringEquals":{"AWS:SourceOwner":"%s"}}}]}`, arn, b.accountID)
+}
+
+func (b *Backend) createTopic(_ context.Context, input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	arn := b.arn("sns", b.region, aws.ToString(input.Name))
+
+	if _, ok := b.sns.topics[arn]; ok {
+		return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+	}
+
+	attributes := map[string]string{
+		"DisplayName":             "",
+		"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+		"Owner":                   b.accountID,
+		"Policy":                  b.defaultTopicPolicy(arn),
+		"SubscriptionsConfirmed":  "0",
+		"SubscriptionsDeleted":    "0",
+		"SubscriptionsPending":    "0",
+		"TopicArn":                arn,
+	}
+	if strings.HasSuffix(aws.ToString(input.Name), ".fifo") {
+		attributes["ContentBasedDeduplication"] = "false"
+		attributes["FifoThroughputScope"] = "Topic"
+	}
+	maps.Copy(attributes, input.Attributes)
+
+	topic := &snsTopic{
+		attributes: attributes,
+	}
+	for _, tag := range input.Tags {
+		topic.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+	}
+	b.sns.topics[arn] = topic
+
+	return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+}
+
+func (b *Backend) deleteTopic(_ context.Context, input *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	arn := aws.ToString(input.TopicArn)
+	if _, err := b.findTopic(arn); err != nil {
+		return nil, err
+	}
+
+	delete(b.sns.topics, arn)
+
+	return &sns.DeleteTopicOutput{}, nil
+}
+
+func (b *Backend) getTopicAttributes(_ context.Context, input *sns.GetTopicAttributesInput) (*sns.GetTopicAttributesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, err := b.findTopic(aws.ToString(input.TopicArn))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sns.GetTopicAttributesOutput{Attributes: maps.Clone(topic.attributes)}, nil
+}
+
+func (b *Backend) listTopics(_ context.Context, _ *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var topics []snstypes.Topic
+	for _, arn := range slices.Sorted(maps.Keys(b.sns.topics)) {
+		topics = append(topics, snstypes.Topic{TopicArn: aws.String(arn)})
+	}
+
+	return &sns.ListTopicsOutput{Topics: topics}, nil
+}
+
+func (b *Backend) setTopicAttributes(_ context.Context, input *sns.SetTopicAttributesInput) (*sns.SetTopicAttributesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	arn := aws.ToString(input.TopicArn)
+	topic, err := b.findTopic(arn)
+	if err != nil {
+		return nil, err
+	}
+
+	name, value := aws.ToString(input.AttributeName), aws.ToString(input.AttributeValue)
+	switch {
+	case name == "Policy" && value == "":
+		topic.attributes[name] = b.defaultTopicPolicy(arn)
+	case value == "":
+		delete(topic.attributes, name)
+	default:
+		topic.attributes[name] = value
+	}
+
+	return &sns.SetTopicAttributesOutput{}, nil
+}
+
+func (b *Backend) listSNSTagsForResource(_ context.Context, input *sns.ListTagsForResourceInput) (*sns.ListTagsForResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, err := b.findTopic(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, &snstypes.ResourceNotFoundException{Message: aws.String("Resource does not exist")}
+	}
+
+	var tags []snstypes.Tag
+	for _, k := range topic.tags.sortedKeys() {
+		tags = append(tags, snstypes.Tag{Key: aws.String(k), Value: aws.String(topic.tags[k])})
+	}
+
+	return &sns.ListTagsForResourceOutput{Tags: tags}, nil
+}
+
+func (b *Backend) tagSNSResource(_ context.Context, input *sns.TagResourceInput) (*sns.TagResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, err := b.findTopic(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, &snstypes.ResourceNotFoundException{Message: aws.String("Resource does not exist")}
+	}
+
+	for _, tag := range input.Tags {
+		topic.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+	}
+
+	return &sns.TagResourceOutput{}, nil
+}
+
+func (b *Backend) untagSNSResource(_ context.Context, input *sns.UntagResourceInput) (*sns.UntagResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, err := b.findTopic(aws.ToString(input.ResourceArn))
+	if err != nil {
+		return nil, &snstypes.ResourceNotFoundException{Message: aws.String("Resource does not exist")}
+	}
+
+	topic.tags.remove(input.TagKeys)
+
+	return &sns.UntagResourceOutput{}, nil
+}
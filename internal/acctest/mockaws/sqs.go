@@ -0,0 +1,233 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+type sqsState struct {
+	// queues are keyed by queue URL.
+	queues map[string]*sqsQueue
+}
+
+type sqsQueue struct {
+	name       string
+	attributes map[string]string
+	tags       tags
+}
+
+func (b *Backend) registerSQS() {
+	b.sqs.queues = make(map[string]*sqsQueue)
+
+	b.register(sqs.ServiceID, "CreateQueue", handle(b.createQueue))
+	b.register(sqs.ServiceID, "DeleteQueue", handle(b.deleteQueue))
+	b.register(sqs.ServiceID, "GetQueueAttributes", handle(b.getQueueAttributes))
+	b.register(sqs.ServiceID, "GetQueueUrl", handle(b.getQueueURL))
+	b.register(sqs.ServiceID, "ListQueueTags", handle(b.listQueueTags))
+	b.register(sqs.ServiceID, "ListQueues", handle(b.listQueues))
+	b.register(sqs.ServiceID, "SetQueueAttributes", handle(b.setQueueAttributes))
+	b.register(sqs.ServiceID, "TagQueue", handle(b.tagQueue))
+	b.register(sqs.ServiceID, "UntagQueue", handle(b.untagQueue))
+}
+
+func (b *Backend) queueURL(name string) string {
+	return fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", b.region, b.accountID, name)
+}
+
+func errQueueDoesNotExist() error {
+	return &sqstypes.QueueDoesNotExist{
+		Message:           aws.String("The specified queue does not exist."),
+		ErrorCodeOverride: aws.String("AWS.SimpleQueueService.NonExistentQueue"),
+	}
+}
+
+// findQueue returns the queue with the specified URL. b.mu must be held.
+func (b *Backend) findQueue(url string) (*sqsQueue, error) {
+	queue, ok := b.sqs.queues[url]
+	if !ok {
+		return nil, errQueueDoesNotExist()
+	}
+
+	return queue, nil
+}
+
+func (b *Backend) createQueue(_ context.Context, input *sqs.CreateQueueInput) (*sqs.CreateQueueOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.QueueName)
+	url := b.queueURL(name)
+
+	if queue, ok := b.sqs.queues[url]; ok {
+		for k, v := range input.Attributes {
+			if queue.attributes[k] != v {
+				return nil, &sqstypes.QueueNameExists{Message: aws.String("A queue already exists with the same name and a different value for attribute " + k)}
+			}
+		}
+
+		return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+	}
+
+	attributes := map[string]string{
+		string(sqstypes.QueueAttributeNameDelaySeconds):                  "0",
+		string(sqstypes.QueueAttributeNameMaximumMessageSize):            "262144",
+		string(sqstypes.QueueAttributeNameMessageRetentionPeriod):        "345600",
+		string(sqstypes.QueueAttributeNameReceiveMessageWaitTimeSeconds): "0",
+		string(sqstypes.QueueAttributeNameSqsManagedSseEnabled):          "true",
+		string(sqstypes.QueueAttributeNameVisibilityTimeout):             "30",
+	}
+	if strings.HasSuffix(name, ".fifo") {
+		attributes[string(sqstypes.QueueAttributeNameFifoQueue)] = "true"
+		attributes[string(sqstypes.QueueAttributeNameContentBasedDeduplication)] = "false"
+		attributes[string(sqstypes.QueueAttributeNameDeduplicationScope)] = "queue"
+		attributes[string(sqstypes.QueueAttributeNameFifoThroughputLimit)] = "perQueue"
+	}
+	maps.Copy(attributes, input.Attributes)
+	if attributes[string(sqstypes.QueueAttributeNameKmsMasterKeyId)] != "" {
+		attributes[string(sqstypes.QueueAttributeNameSqsManagedSseEnabled)] = "false"
+		if _, ok := attributes[string(sqstypes.QueueAttributeNameKmsDataKeyReusePeriodSeconds)]; !ok {
+			attributes[string(sqstypes.QueueAttributeNameKmsDataKeyReusePeriodSeconds)] = "300"
+		}
+	}
+	attributes[string(sqstypes.QueueAttributeNameQueueArn)] = b.arn("sqs", b.region, name)
+
+	queue := &sqsQueue{
+		name:       name,
+		attributes: attributes,
+	}
+	queue.tags.set(input.Tags)
+	b.sqs.queues[url] = queue
+
+	return &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (b *Backend) deleteQueue(_ context.Context, input *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	url := aws.ToString(input.QueueUrl)
+	if _, err := b.findQueue(url); err != nil {
+		return nil, err
+	}
+
+	delete(b.sqs.queues, url)
+
+	return &sqs.DeleteQueueOutput{}, nil
+}
+
+func (b *Backend) getQueueAttributes(_ context.Context, input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue, err := b.findQueue(aws.ToString(input.QueueUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string)
+	for _, name := range input.AttributeNames {
+		if name == sqstypes.QueueAttributeNameAll {
+			maps.Copy(attributes, queue.attributes)
+			attributes[string(sqstypes.QueueAttributeNameApproximateNumberOfMessages)] = "0"
+			attributes[string(sqstypes.QueueAttributeNameApproximateNumberOfMessagesDelayed)] = "0"
+			attributes[string(sqstypes.QueueAttributeNameApproximateNumberOfMessagesNotVisible)] = "0"
+			continue
+		}
+
+		if v, ok := queue.attributes[string(name)]; ok {
+			attributes[string(name)] = v
+		}
+	}
+
+	return &sqs.GetQueueAttributesOutput{Attributes: attributes}, nil
+}
+
+func (b *Backend) getQueueURL(_ context.Context, input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	url := b.queueURL(aws.ToString(input.QueueName))
+	if _, err := b.findQueue(url); err != nil {
+		return nil, err
+	}
+
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(url)}, nil
+}
+
+func (b *Backend) listQueues(_ context.Context, input *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var urls []string
+	for _, url := range slices.Sorted(maps.Keys(b.sqs.queues)) {
+		if strings.HasPrefix(b.sqs.queues[url].name, aws.ToString(input.QueueNamePrefix)) {
+			urls = append(urls, url)
+		}
+	}
+
+	return &sqs.ListQueuesOutput{QueueUrls: urls}, nil
+}
+
+func (b *Backend) setQueueAttributes(_ context.Context, input *sqs.SetQueueAttributesInput) (*sqs.SetQueueAttributesOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue, err := b.findQueue(aws.ToString(input.QueueUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(queue.attributes, input.Attributes)
+
+	return &sqs.SetQueueAttributesOutput{}, nil
+}
+
+func (b *Backend) listQueueTags(_ context.Context, input *sqs.ListQueueTagsInput) (*sqs.ListQueueTagsOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue, err := b.findQueue(aws.ToString(input.QueueUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqs.ListQueueTagsOutput{Tags: maps.Clone(queue.tags)}, nil
+}
+
+func (b *Backend) tagQueue(_ context.Context, input *sqs.TagQueueInput) (*sqs.TagQueueOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue, err := b.findQueue(aws.ToString(input.QueueUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	queue.tags.set(input.Tags)
+
+	return &sqs.TagQueueOutput{}, nil
+}
+
+func (b *Backend) untagQueue(_ context.Context, input *sqs.UntagQueueInput) (*sqs.UntagQueueOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	queue, err := b.findQueue(aws.ToString(input.QueueUrl))
+	if err != nil {
+		return nil, err
+	}
+
+	queue.tags.remove(input.TagKeys)
+
+	return &sqs.UntagQueueOutput{}, nil
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+type ssmState struct {
+	// parameters are keyed by name.
+	parameters map[string]*ssmParameter
+}
+
+type ssmParameter struct {
+	metadata ssmtypes.ParameterMetadata
+	value    string
+	tags     tags
+}
+
+func (b *Backend) registerSSM() {
+	b.ssm.parameters = make(map[string]*ssmParameter)
+
+	b.register(ssm.ServiceID, "AddTagsToResource", handle(b.addTagsToResource))
+	b.register(ssm.ServiceID, "DeleteParameter", handle(b.deleteParameter))
+	b.register(ssm.ServiceID, "DescribeParameters", handle(b.describeParameters))
+	b.register(ssm.ServiceID, "GetParameter", handle(b.getParameter))
+	b.register(ssm.ServiceID, "ListTagsForResource", handle(b.listSSMTagsForResource))
+	b.register(ssm.ServiceID, "PutParameter", handle(b.putParameter))
+	b.register(ssm.ServiceID, "RemoveTagsFromResource", handle(b.removeTagsFromResource))
+}
+
+// findParameter returns the parameter with the specified name or ARN. b.mu must be held.
+func (b *Backend) findParameter(name string) (*ssmParameter, error) {
+	if strings.HasPrefix(name, "arn:") {
+		_, name, _ = strings.Cut(name, ":parameter")
+	}
+
+	parameter, ok := b.ssm.parameters[name]
+	if !ok {
+		return nil, &ssmtypes.ParameterNotFound{}
+	}
+
+	return parameter, nil
+}
+
+func (b *Backend) parameterARN(name string) string {
+	return b.arn("ssm", b.region, "parameter/"+strings.TrimPrefix(name, "/"))
+}
+
+func (b *Backend) putParameter(_ context.Context, input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.Name)
+	parameter, ok := b.ssm.parameters[name]
+
+	switch {
+	case ok && !aws.ToBool(input.Overwrite):
+		return nil, &ssmtypes.ParameterAlreadyExists{Message: aws.String("The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")}
+	case ok && len(input.Tags) > 0:
+		return nil, &ssmtypes.InvalidParameters{Message: aws.String("Invalid parameters: Tags and Overwrite can't be used together.")}
+	case !ok:
+		parameter = &ssmParameter{
+			metadata: ssmtypes.ParameterMetadata{
+				ARN:      aws.String(b.parameterARN(name)),
+				DataType: aws.String("text"),
+				Name:     aws.String(name),
+				Tier:     ssmtypes.ParameterTierStandard,
+			},
+		}
+		for _, tag := range input.Tags {
+			parameter.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+		}
+		b.ssm.parameters[name] = parameter
+	}
+
+	m := &parameter.metadata
+	m.AllowedPattern = input.AllowedPattern
+	if input.DataType != nil {
+		m.DataType = input.DataType
+	}
+	m.Description = input.Description
+	if input.Type != "" {
+		m.Type = input.Type
+	}
+	if m.Type == ssmtypes.ParameterTypeSecureString {
+		m.KeyId = aws.String("alias/aws/ssm")
+		if input.KeyId != nil {
+			m.KeyId = input.KeyId
+		}
+	} else {
+		m.KeyId = nil
+	}
+	if input.Tier != "" && input.Tier != ssmtypes.ParameterTierIntelligentTiering {
+		m.Tier = input.Tier
+	}
+	m.LastModifiedDate = aws.Time(time.Now())
+	m.LastModifiedUser = aws.String(b.callerARN())
+	m.Version++
+	parameter.value = aws.ToString(input.Value)
+
+	return &ssm.PutParameterOutput{Tier: m.Tier, Version: m.Version}, nil
+}
+
+func (b *Backend) getParameter(_ context.Context, input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	parameter, err := b.findParameter(aws.ToString(input.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	m := parameter.metadata
+	value := parameter.value
+	// The fake has no KMS keys, so "encrypted" values are the plaintext.
+	if m.Type == ssmtypes.ParameterTypeSecureString && !aws.ToBool(input.WithDecryption) {
+		value = "AQICAHgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=="
+	}
+
+	return &ssm.GetParameterOutput{
+		Parameter: &ssmtypes.Parameter{
+			ARN:              m.ARN,
+			DataType:         m.DataType,
+			LastModifiedDate: m.LastModifiedDate,
+			Name:             m.Name,
+			Type:             m.Type,
+			Value:            aws.String(value),
+			Version:          m.Version,
+		},
+	}, nil
+}
+
+func (b *Backend) describeParameters(_ context.Context, input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var parameters []ssmtypes.ParameterMetadata
+	for _, name := range slices.Sorted(maps.Keys(b.ssm.parameters)) {
+		if parameterMatchesFilters(name, input.ParameterFilters) {
+			parameters = append(parameters, b.ssm.parameters[name].metadata)
+		}
+	}
+
+	return &ssm.DescribeParametersOutput{Parameters: parameters}, nil
+}
+
+// parameterMatchesFilters reports whether a parameter name matches all Name filters. Other filters are ignored.
+func parameterMatchesFilters(name string, filters []ssmtypes.ParameterStringFilter) bool {
+	for _, filter := range filters {
+		if aws.ToString(filter.Key) != "Name" {
+			continue
+		}
+
+		match := func(v string) bool { return v == name }
+		if aws.ToString(filter.Option) == "BeginsWith" {
+			match = func(v string) bool { return strings.HasPrefix(name, v) }
+		}
+		if !slices.ContainsFunc(filter.Values, match) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *Backend) deleteParameter(_ context.Context, input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := aws.ToString(input.Name)
+	if _, err := b.findParameter(name); err != nil {
+		return nil, err
+	}
+
+	delete(b.ssm.parameters, name)
+
+	return &ssm.DeleteParameterOutput{}, nil
+}
+
+// findTaggedParameter returns the parameter that a tagging operation applies to. b.mu must be held.
+func (b *Backend) findTaggedParameter(resourceType ssmtypes.ResourceTypeForTagging, resourceID string) (*ssmParameter, error) {
+	if resourceType != ssmtypes.ResourceTypeForTaggingParameter {
+		return nil, &ssmtypes.InvalidResourceType{Message: aws.String("mockaws: unsupported resource type " + string(resourceType))}
+	}
+
+	parameter, err := b.findParameter(resourceID)
+	if err != nil {
+		return nil, &ssmtypes.InvalidResourceId{}
+	}
+
+	return parameter, nil
+}
+
+func (b *Backend) addTagsToResource(_ context.Context, input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	parameter, err := b.findTaggedParameter(input.ResourceType, aws.ToString(input.ResourceId))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range input.Tags {
+		parameter.tags.put(aws.ToString(tag.Key), aws.ToString(tag.Value))
+	}
+
+	return &ssm.AddTagsToResourceOutput{}, nil
+}
+
+func (b *Backend) listSSMTagsForResource(_ context.Context, input *ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	parameter, err := b.findTaggedParameter(input.ResourceType, aws.ToString(input.ResourceId))
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []ssmtypes.Tag
+	for _, k := range parameter.tags.sortedKeys() {
+		tags = append(tags, ssmtypes.Tag{Key: aws.String(k), Value: aws.String(parameter.tags[k])})
+	}
+
+	return &ssm.ListTagsForResourceOutput{TagList: tags}, nil
+}
+
+func (b *Backend) removeTagsFromResource(_ context.Context, input *ssm.RemoveTagsFromResourceInput) (*ssm.RemoveTagsFromResourceOutput, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	parameter, err := b.findTaggedParameter(input.ResourceType, aws.ToString(input.ResourceId))
+	if err != nil {
+		return nil, err
+	}
+
+	parameter.tags.remove(input.TagKeys)
+
+	return &ssm.RemoveTagsFromResourceOutput{}, nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const mockUserName = "mockaws"
+
+func (b *Backend) registerSTS() {
+	b.register(sts.ServiceID, "GetCallerIdentity", handle(b.getCallerIdentity))
+}
+
+func (b *Backend) callerARN() string {
+	return fmt.Sprintf("arn:aws:iam::%s:user/%s", b.accountID, mockUserName)
+}
+
+func (b *Backend) getCallerIdentity(_ context.Context, _ *sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
+	return &sts.GetCallerIdentityOutput{
+		Account: aws.String(b.accountID),
+		Arn:     aws.String(b.callerARN()),
+		UserId:  aws.String("AIDAMOCKAWSUSER"),
+	}, nil
+}
+
+// getCallerIdentityXML returns the Query protocol response to STS GetCallerIdentity.
+func (b *Backend) getCallerIdentityXML() string {
+	return fmt.Sprintf(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>%s</Arn>
+    <UserId>AIDAMOCKAWSUSER</UserId>
+    <Account>%s</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>%s</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`, b.callerARN(), b.accountID, b.nextRequestID())
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mockaws
+
+import (
+	"maps"
+	"slices"
+)
+
+// tags are a resource's tags.
+type tags map[string]string
+
+func (t *tags) set(kvs map[string]string) {
+	if *t == nil {
+		*t = make(tags, len(kvs))
+	}
+	maps.Copy(*t, kvs)
+}
+
+func (t *tags) put(k, v string) {
+	if *t == nil {
+		*t = make(tags)
+	}
+	(*t)[k] = v
+}
+
+func (t tags) remove(keys []string) {
+	for _, k := range keys {
+		delete(t, k)
+	}
+}
+
+// sortedKeys returns the tag keys in a stable order so that list operations are deterministic.
+func (t tags) sortedKeys() []string {
+	return slices.Sorted(maps.Keys(t))
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ plancheck.PlanCheck = expectDefaultTagsPropagatedCheck{}
+
+type expectDefaultTagsPropagatedCheck struct {
+	base        Base
+	defaultTags map[string]string
+}
+
+func (e expectDefaultTagsPropagatedCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if isUnknown(resource.Change.AfterUnknown, tfjsonpath.New(names.AttrTagsAll)) {
+		response.Error = fmt.Errorf("%s - %s is unknown in plan", resource.Address, names.AttrTagsAll)
+
+		return
+	}
+
+	tags, err := stringMapAt(resource.Change.After, tfjsonpath.New(names.AttrTags))
+	if err != nil {
+		response.Error = fmt.Errorf("%s - %w", resource.Address, err)
+
+		return
+	}
+
+	tagsAll, err := stringMapAt(resource.Change.After, tfjsonpath.New(names.AttrTagsAll))
+	if err != nil {
+		response.Error = fmt.Errorf("%s - %w", resource.Address, err)
+
+		return
+	}
+
+	want := maps.Clone(e.defaultTags)
+	if want == nil {
+		want = make(map[string]string)
+	}
+	// Resource tags override default tags with the same key.
+	maps.Copy(want, tags)
+
+	var diffs []string
+	for _, k := range slices.Sorted(maps.Keys(want)) {
+		if got, ok := tagsAll[k]; !ok {
+			diffs = append(diffs, fmt.Sprintf("missing %q", k))
+		} else if got != want[k] {
+			diffs = append(diffs, fmt.Sprintf("%q = %q, want %q", k, got, want[k]))
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(tagsAll)) {
+		if _, ok := want[k]; !ok {
+			diffs = append(diffs, fmt.Sprintf("unexpected %q", k))
+		}
+	}
+
+	if len(diffs) > 0 {
+		response.Error = fmt.Errorf("%s - planned %s is not the union of default tags and %s: %s", resource.Address, names.AttrTagsAll, names.AttrTags, strings.Join(diffs, ", "))
+
+		return
+	}
+}
+
+// ExpectDefaultTagsPropagated checks that the planned `tags_all` of the resource is exactly
+// the provider's default tags overlaid with the resource's planned `tags`.
+func ExpectDefaultTagsPropagated(resourceAddress string, defaultTags map[string]string) plancheck.PlanCheck {
+	return expectDefaultTagsPropagatedCheck{
+		base:        NewBase(resourceAddress),
+		defaultTags: defaultTags,
+	}
+}
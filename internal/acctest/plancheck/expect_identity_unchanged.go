@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+var _ plancheck.PlanCheck = expectIdentityUnchangedCheck{}
+
+type expectIdentityUnchangedCheck struct {
+	base Base
+}
+
+func (e expectIdentityUnchangedCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if resource.Change.BeforeIdentity == nil {
+		response.Error = fmt.Errorf("%s - Prior identity not found in plan. Either the resource is being created, the resource does not support identity, or the Terraform version running the test does not support identity. (must be v1.12+)", resource.Address)
+
+		return
+	}
+
+	if resource.Change.AfterIdentity == nil {
+		response.Error = fmt.Errorf("%s - Planned identity not found in plan", resource.Address)
+
+		return
+	}
+
+	if !reflect.DeepEqual(resource.Change.BeforeIdentity, resource.Change.AfterIdentity) {
+		response.Error = fmt.Errorf("%s - identity changed from %v to %v", resource.Address, resource.Change.BeforeIdentity, resource.Change.AfterIdentity)
+
+		return
+	}
+}
+
+// ExpectIdentityUnchanged checks that the planned identity of the resource is the same as its prior identity.
+func ExpectIdentityUnchanged(resourceAddress string) plancheck.PlanCheck {
+	return expectIdentityUnchangedCheck{
+		base: NewBase(resourceAddress),
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var _ plancheck.PlanCheck = expectNoReplacementCheck{}
+
+type expectNoReplacementCheck struct {
+	base           Base
+	attributePaths []tfjsonpath.Path
+}
+
+func (e expectNoReplacementCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	if !resource.Change.Actions.Replace() {
+		return
+	}
+
+	for _, v := range resource.Change.ReplacePaths {
+		replacePath := replacePathString(v)
+
+		for _, attributePath := range e.attributePaths {
+			if p := attributePath.String(); replacePath == p || strings.HasPrefix(replacePath, p+".") {
+				response.Error = fmt.Errorf("%s - planned for replacement by change to attribute at path: %s", resource.Address, replacePath)
+
+				return
+			}
+		}
+	}
+}
+
+// ExpectNoReplacement checks that, if the resource is planned for replacement,
+// the replacement is not caused by a change to any of the specified attributes or their nested attributes.
+func ExpectNoReplacement(resourceAddress string, attributePaths ...tfjsonpath.Path) plancheck.PlanCheck {
+	return expectNoReplacementCheck{
+		base:           NewBase(resourceAddress),
+		attributePaths: attributePaths,
+	}
+}
+
+// replacePathString formats a `replace_paths` element from the JSON plan in the same way as tfjsonpath.Path's String method.
+func replacePathString(v any) string {
+	steps, ok := v.([]any)
+	if !ok {
+		return fmt.Sprintf("%v", v)
+	}
+
+	var s []string
+	for _, step := range steps {
+		s = append(s, fmt.Sprintf("%v", step))
+	}
+
+	return strings.Join(s, ".")
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+var _ plancheck.PlanCheck = expectNoUnknownValuesCheck{}
+
+type expectNoUnknownValuesCheck struct {
+	base           Base
+	attributePaths []tfjsonpath.Path
+}
+
+func (e expectNoUnknownValuesCheck) CheckPlan(ctx context.Context, request plancheck.CheckPlanRequest, response *plancheck.CheckPlanResponse) {
+	resource, ok := e.base.ResourceFromState(request, response)
+	if !ok {
+		return
+	}
+
+	for _, attributePath := range e.attributePaths {
+		if isUnknown(resource.Change.AfterUnknown, attributePath) {
+			response.Error = fmt.Errorf("%s - attribute at path: %s is unknown or contains unknown values", resource.Address, attributePath.String())
+
+			return
+		}
+	}
+}
+
+// ExpectNoUnknownValues checks that none of the specified attributes, nor any value nested within them, is unknown in the plan.
+// Use in a post-refresh or post-apply plan check to catch values that are not set by Read.
+func ExpectNoUnknownValues(resourceAddress string, attributePaths ...tfjsonpath.Path) plancheck.PlanCheck {
+	return expectNoUnknownValuesCheck{
+		base:           NewBase(resourceAddress),
+		attributePaths: attributePaths,
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck_test
+
+import (
+	"context"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	tfplancheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/plancheck"
+)
+
+const resourceAddress = "aws_test.test"
+
+func testPlan(change tfjson.Change) *tfjson.Plan {
+	return &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: resourceAddress,
+				Change:  &change,
+			},
+		},
+	}
+}
+
+func runPlanCheck(t *testing.T, check plancheck.PlanCheck, change tfjson.Change) error {
+	t.Helper()
+
+	var response plancheck.CheckPlanResponse
+	check.CheckPlan(context.Background(), plancheck.CheckPlanRequest{Plan: testPlan(change)}, &response)
+
+	return response.Error
+}
+
+func TestExpectDefaultTagsPropagated(t *testing.T) {
+	t.Parallel()
+
+	defaultTags := map[string]string{
+		"Environment": "test",
+		"Owner":       "team",
+	}
+
+	testCases := map[string]struct {
+		after        map[string]any
+		afterUnknown map[string]any
+		expectError  bool
+	}{
+		"propagated": {
+			after: map[string]any{
+				"tags":     map[string]any{"Name": "test", "Owner": "me"},
+				"tags_all": map[string]any{"Environment": "test", "Name": "test", "Owner": "me"},
+			},
+		},
+		"null tags": {
+			after: map[string]any{
+				"tags":     nil,
+				"tags_all": map[string]any{"Environment": "test", "Owner": "team"},
+			},
+		},
+		"missing default tag": {
+			after: map[string]any{
+				"tags":     map[string]any{"Name": "test"},
+				"tags_all": map[string]any{"Environment": "test", "Name": "test"},
+			},
+			expectError: true,
+		},
+		"not overridden": {
+			after: map[string]any{
+				"tags":     map[string]any{"Owner": "me"},
+				"tags_all": map[string]any{"Environment": "test", "Owner": "team"},
+			},
+			expectError: true,
+		},
+		"unexpected tag": {
+			after: map[string]any{
+				"tags":     map[string]any{},
+				"tags_all": map[string]any{"Environment": "test", "Owner": "team", "Name": "test"},
+			},
+			expectError: true,
+		},
+		"unknown": {
+			after: map[string]any{
+				"tags": map[string]any{},
+			},
+			afterUnknown: map[string]any{
+				"tags_all": true,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := runPlanCheck(t, tfplancheck.ExpectDefaultTagsPropagated(resourceAddress, defaultTags), tfjson.Change{
+				Actions:      tfjson.Actions{tfjson.ActionUpdate},
+				After:        testCase.after,
+				AfterUnknown: testCase.afterUnknown,
+			})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestExpectNoReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		actions      tfjson.Actions
+		replacePaths []any
+		expectError  bool
+	}{
+		"update": {
+			actions: tfjson.Actions{tfjson.ActionUpdate},
+		},
+		"replaced by other attribute": {
+			actions:      tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+			replacePaths: []any{[]any{"name"}},
+		},
+		"replaced by attribute": {
+			actions:      tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+			replacePaths: []any{[]any{"name"}, []any{"description"}},
+			expectError:  true,
+		},
+		"replaced by nested attribute": {
+			actions:      tfjson.Actions{tfjson.ActionCreate, tfjson.ActionDelete},
+			replacePaths: []any{[]any{"configuration", float64(0), "mode"}},
+			expectError:  true,
+		},
+		"replaced by attribute with common prefix": {
+			actions:      tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+			replacePaths: []any{[]any{"descriptions"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			check := tfplancheck.ExpectNoReplacement(resourceAddress, tfjsonpath.New("description"), tfjsonpath.New("configuration"))
+			err := runPlanCheck(t, check, tfjson.Change{
+				Actions:      testCase.actions,
+				ReplacePaths: testCase.replacePaths,
+			})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestExpectIdentityUnchanged(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		beforeIdentity, afterIdentity any
+		expectError                   bool
+	}{
+		"unchanged": {
+			beforeIdentity: map[string]any{"account_id": "123456789012", "name": "test"},
+			afterIdentity:  map[string]any{"account_id": "123456789012", "name": "test"},
+		},
+		"changed": {
+			beforeIdentity: map[string]any{"account_id": "123456789012", "name": "test"},
+			afterIdentity:  map[string]any{"account_id": "123456789012", "name": "other"},
+			expectError:    true,
+		},
+		"no prior identity": {
+			afterIdentity: map[string]any{"account_id": "123456789012", "name": "test"},
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := runPlanCheck(t, tfplancheck.ExpectIdentityUnchanged(resourceAddress), tfjson.Change{
+				Actions:        tfjson.Actions{tfjson.ActionUpdate},
+				BeforeIdentity: testCase.beforeIdentity,
+				AfterIdentity:  testCase.afterIdentity,
+			})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestExpectNoUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		afterUnknown any
+		expectError  bool
+	}{
+		"all known": {
+			afterUnknown: map[string]any{},
+		},
+		"other attribute unknown": {
+			afterUnknown: map[string]any{"id": true},
+		},
+		"unknown": {
+			afterUnknown: map[string]any{"arn": true},
+			expectError:  true,
+		},
+		"nested unknown": {
+			afterUnknown: map[string]any{"configuration": []any{map[string]any{"mode": false, "version": true}}},
+			expectError:  true,
+		},
+		"nested known": {
+			afterUnknown: map[string]any{"configuration": []any{map[string]any{"mode": false}}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			check := tfplancheck.ExpectNoUnknownValues(resourceAddress, tfjsonpath.New("arn"), tfjsonpath.New("configuration"))
+			err := runPlanCheck(t, check, tfjson.Change{
+				Actions:      tfjson.Actions{tfjson.ActionNoop},
+				AfterUnknown: testCase.afterUnknown,
+			})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plancheck
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// isUnknown returns whether the value at the specified path in a plan's `after_unknown` object is, or contains, an unknown value.
+// Known values are omitted from `after_unknown`, so a path that cannot be traversed is known.
+func isUnknown(afterUnknown any, attributePath tfjsonpath.Path) bool {
+	v, err := tfjsonpath.Traverse(afterUnknown, attributePath)
+	if err != nil {
+		return false
+	}
+
+	return containsUnknown(v)
+}
+
+func containsUnknown(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case []any:
+		for _, v := range v {
+			if containsUnknown(v) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, v := range v {
+			if containsUnknown(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// stringMapAt returns the map of strings at the specified path in a plan's `before` or `after` object.
+// A null or missing value is returned as an empty map.
+func stringMapAt(object any, attributePath tfjsonpath.Path) (map[string]string, error) {
+	v, err := tfjsonpath.Traverse(object, attributePath)
+	if err != nil || v == nil {
+		return map[string]string{}, nil
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("attribute at path: %s is %T, want map", attributePath.String(), v)
+	}
+
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("attribute at path: %s.%s is %T, want string", attributePath.String(), k, v)
+		}
+		result[k] = s
+	}
+
+	return result, nil
+}
@@ -63,11 +63,13 @@ import (
 // as-is) from configuration of a new provider instance in a subsequent
 // step (where the provider must be re-configured, e.g. to pick up
 // changed default_tags). When a new provider instance is detected, the
-// VCR-enabled HTTP client from the prior entry is carried forward to
-// ensure all interactions for the test are recorded to a single cassette.
+// VCR-enabled HTTP client and cassette scrubber from the prior entry are
+// carried forward to ensure all interactions for the test are recorded to
+// a single cassette.
 type providerMetaStore struct {
 	provider *schema.Provider
 	meta     *conns.AWSClient
+	scrubber *vcr.Scrubber
 }
 
 type metaMap map[string]*providerMetaStore
@@ -174,11 +176,13 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Reuse the existing VCR-enabled HTTP client if one was already created
 		// for a prior step, otherwise create a new one with a recorder.
 		var httpClient *http.Client
+		var scrubber *vcr.Scrubber
 		var err error
 		if ok {
 			httpClient = store.meta.HTTPClient(ctx)
+			scrubber = store.scrubber
 		} else {
-			httpClient, err = vcrHTTPClient(ctx, testName)
+			httpClient, scrubber, err = vcrHTTPClient(ctx, testName)
 			if err != nil {
 				return nil, sdkdiag.AppendFromErr(diags, err)
 			}
@@ -202,6 +206,12 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
+		// The account ID is only known once the provider has been configured.
+		// When replaying, it is the placeholder from the recorded STS response.
+		if !ok {
+			scrubber.AddRule(vcr.AccountIDRule(meta.AccountID(ctx), vcr.AccountIDPlaceholder))
+		}
+
 		s, err := vcrRandomnessSource(t)
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -209,7 +219,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		meta.SetRandomnessSource(s.source)
 
 		providerMetas.Lock()
-		providerMetas[testName] = &providerMetaStore{provider: provider, meta: meta}
+		providerMetas[testName] = &providerMetaStore{provider: provider, meta: meta, scrubber: scrubber}
 		providerMetas.Unlock()
 
 		return meta, diags
@@ -224,18 +234,19 @@ func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
 }
 
 // vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
+//
+// Requests are compared with interactions as-is and then, if they do not
+// match, after scrubbing, so that both cassettes recorded before scrubbing was
+// introduced and scrubbed cassettes recorded in another environment match.
+func vcrMatcherFunc(ctx context.Context, scrubber *vcr.Scrubber) recorder.MatcherFunc {
 	return func(r *http.Request, i cassette.Request) bool {
 		if r.Method != i.Method {
 			return false
 		}
 
-		if r.URL.String() != i.URL {
-			return false
-		}
-
+		requestURL := r.URL.String()
 		if r.Body == nil {
-			return true
+			return requestURL == i.URL || scrubber.Scrub(requestURL) == i.URL
 		}
 
 		var b bytes.Buffer
@@ -248,46 +259,68 @@ func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
 
 		r.Body = io.NopCloser(&b)
 		body := b.String()
-		// If body matches identically, we are done.
-		if body == i.Body {
+		contentType := r.Header.Get("Content-Type")
+
+		if requestURL == i.URL && vcrBodyMatches(ctx, contentType, body, i.Body) {
 			return true
 		}
 
-		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-		switch contentType := r.Header.Get("Content-Type"); contentType {
-		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-			// JSON might be the same, but reordered. Try parsing and comparing.
-			return tfjson.EqualStrings(body, i.Body)
-
-		case "application/xml":
-			// XML might be the same, but reordered. Try parsing and comparing.
-			var requestXML, cassetteXML any
-
-			if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
+		return scrubber.Scrub(requestURL) == i.URL && vcrBodyMatches(ctx, contentType, scrubber.Scrub(body), i.Body)
+	}
+}
 
-			if err := xml.Unmarshal([]byte(i.Body), &cassetteXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
+// vcrBodyMatches reports whether a request body matches a stored interaction's body.
+func vcrBodyMatches(ctx context.Context, contentType, body, cassetteBody string) bool {
+	// If body matches identically, we are done.
+	if body == cassetteBody {
+		return true
+	}
+
+	// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+	switch contentType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		return tfjson.EqualStrings(body, cassetteBody)
 
-			return reflect.DeepEqual(requestXML, cassetteXML)
+	case "application/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var requestXML, cassetteXML any
+
+		if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
+				"error": err,
+			})
+			return false
 		}
 
-		return false
+		if err := xml.Unmarshal([]byte(cassetteBody), &cassetteXML); err != nil {
+			tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return reflect.DeepEqual(requestXML, cassetteXML)
 	}
+
+	return false
+}
+
+// vcrScrubber returns a scrubber that redacts credentials and request
+// signatures and replaces the acceptance test Regions with placeholders.
+func vcrScrubber() *vcr.Scrubber {
+	scrubber := vcr.NewScrubber(vcr.SensitiveValueRules()...)
+	scrubber.AddRule(vcr.RegionRule(Region(), vcr.RegionPlaceholder))
+	scrubber.AddRule(vcr.RegionRule(AlternateRegion(), vcr.AlternateRegionPlaceholder))
+	scrubber.AddRule(vcr.RegionRule(ThirdRegion(), vcr.ThirdRegionPlaceholder))
+
+	return scrubber
 }
 
-func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
+func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, *vcr.Scrubber, error) {
 	vcrMode, err := vcr.Mode()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Real transport config, cribbed from aws-sdk-go-base.
@@ -301,21 +334,42 @@ func vcrHTTPClient(ctx context.Context, testName string) (*http.Client, error) {
 	}
 
 	cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
+	scrubber := vcrScrubber()
 
 	// Create a VCR recorder around a default HTTP client.
-	r, err := recorder.New(cassetteName,
+	// Interactions are scrubbed when the cassette is saved rather than when
+	// they are captured so that the provider sees real responses while recording.
+	opts := []recorder.Option{
 		recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-		recorder.WithMatcher(vcrMatcherFunc(ctx)),
+		recorder.WithHook(scrubber.ScrubInteraction, recorder.BeforeSaveHook),
+		recorder.WithMatcher(vcrMatcherFunc(ctx, scrubber)),
 		recorder.WithMode(vcrMode),
 		recorder.WithRealTransport(httpClient.Transport),
 		recorder.WithSkipRequestLatency(true),
-	)
+	}
+	if vcrMode == recorder.ModeReplayOnly {
+		opts = append(opts, recorder.WithHook(scrubber.UnscrubResponse, recorder.BeforeResponseReplayHook))
+	}
+
+	// Optionally report which interactions were not replayed and which
+	// requests had no recorded interaction.
+	var report *vcr.CoverageReport
+	if vcrMode == recorder.ModeReplayOnly && vcr.ReportPath() != "" {
+		report = vcr.NewCoverageReport(testName, cassetteName+".yaml", scrubber.Scrub)
+		opts = append(opts, recorder.WithHook(report.RecorderStopHook, recorder.OnRecorderStopHook))
+	}
+
+	r, err := recorder.New(cassetteName, opts...)
 	if err != nil {
-		return httpClient, err
+		return httpClient, scrubber, err
 	}
 
-	httpClient.Transport = r
-	return httpClient, nil
+	if report != nil {
+		httpClient.Transport = &vcr.CoverageTransport{Recorder: r, Report: report}
+	} else {
+		httpClient.Transport = r
+	}
+	return httpClient, scrubber, nil
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing
@@ -385,12 +439,20 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	providerMetas.Unlock()
 
 	if ok {
-		if !t.Failed() {
-			if v, ok := store.meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
+		switch v := store.meta.HTTPClient(ctx).Transport.(type) {
+		case *recorder.Recorder:
+			if !t.Failed() {
 				if err := v.Stop(); err != nil {
 					t.Error(err)
 				}
 			}
+		case *vcr.CoverageTransport:
+			// Stopping a replaying recorder saves nothing, so it is always
+			// stopped to determine which interactions were not replayed.
+			if err := v.Stop(); err != nil {
+				t.Error(err)
+			}
+			writeVCRCoverageReport(t, v.Report)
 		}
 
 		providerMetas.Lock()
@@ -420,6 +482,21 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
+// writeVCRCoverageReport logs a stale cassette's unused interactions and
+// unmatched requests and saves the test's coverage report.
+func writeVCRCoverageReport(t *testing.T, report *vcr.CoverageReport) {
+	t.Helper()
+
+	report.Failed = t.Failed()
+	if report.IsStale() {
+		t.Logf("VCR cassette is stale, re-record it in RECORD_ONLY mode:\n%s", report.Diff())
+	}
+
+	if err := report.WriteFile(vcr.ReportPath()); err != nil {
+		t.Error(err)
+	}
+}
+
 // vcrConfigureWrapper returns a [ConfigureWrapper] that records or replays
 // AWS API HTTP traffic for t. It returns nil when [vcr.IsEnabled] is false,
 // which [chainConfigureWrappers] silently skips, so the wrapper is a true
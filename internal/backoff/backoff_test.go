@@ -140,3 +140,17 @@ func TestLoopWithTimeoutNoGracePeriod(t *testing.T) {
 		t.Errorf("Iterations = %v, want %v", got, want)
 	}
 }
+
+func TestZeroDelayContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	if IsZeroDelay(ctx) {
+		t.Error("IsZeroDelay = true, want false")
+	}
+
+	ctx = NewZeroDelayContext(ctx)
+	if !IsZeroDelay(ctx) {
+		t.Error("IsZeroDelay = false, want true")
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backoff
+
+import (
+	"context"
+)
+
+type contextKeyType int
+
+var zeroDelayContextKey contextKeyType
+
+// NewZeroDelayContext returns a new context indicating that polling loops should not delay between attempts.
+//
+// This is used when acceptance tests run against an in-memory AWS backend, where resources reach their target state immediately.
+func NewZeroDelayContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, zeroDelayContextKey, true)
+}
+
+// IsZeroDelay returns whether the context indicates that polling loops should not delay between attempts.
+func IsZeroDelay(ctx context.Context) bool {
+	v, _ := ctx.Value(zeroDelayContextKey).(bool)
+	return v
+}
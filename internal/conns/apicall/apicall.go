@@ -2,7 +2,8 @@
 // SPDX-License-Identifier: MPL-2.0
 
 // Package apicall captures AWS SDK for Go v2 operation invocations made
-// through the provider's service clients, for use in tests.
+// through the provider's service clients, for use in tests and in opt-in
+// API call summary reports (see Summarize).
 //
 // The Smithy middleware is opt-in per request: when no Recorder is attached
 // to the operation context (see NewContext), it is a no-op.
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/smithy-go/middleware"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -38,17 +40,20 @@ type Call struct {
 	At        time.Time     // Time of recording (after the call returned).
 	Duration  time.Duration // Wall-clock time spent in the SDK stack, including retries.
 	RequestID string        // AWS request ID from the response, when available.
+	Attempts  int           // Number of attempts, including retries, when available.
+	Throttles int           // Number of attempts that failed with a throttling error.
 }
 
 // Cursor is an opaque position into a Recorder's call log. Use Mark to obtain
 // one and CallsSince/ContainsSince to scope assertions to a window.
 type Cursor int
 
-// Recorder collects API call records. Construct via NewRecorder.
+// Recorder collects API call records. Construct via NewRecorder or NewSummaryRecorder.
 // Safe for concurrent use.
 type Recorder struct {
-	mu    sync.Mutex
-	calls []Call
+	mu      sync.Mutex
+	calls   []Call
+	summary *Summary // Running totals, if calls are not kept.
 }
 
 // NewRecorder returns an empty Recorder.
@@ -56,6 +61,16 @@ func NewRecorder() *Recorder {
 	return &Recorder{}
 }
 
+// NewSummaryRecorder returns an empty Recorder which keeps running totals of
+// the calls recorded, rather than every call, so that its memory use does not
+// grow with the number of calls.
+// Its call log is always empty.
+func NewSummaryRecorder() *Recorder {
+	return &Recorder{
+		summary: NewSummary(),
+	}
+}
+
 // Record appends a call to the log. Convenience wrapper that fills in only
 // the service, operation, error, and timestamp; tests typically use this.
 // Middleware uses RecordCall to populate richer fields.
@@ -68,16 +83,34 @@ func (r *Recorder) Record(service, operation string, err error) {
 	})
 }
 
-// RecordCall appends c to the log. If c.At is zero, it is set to time.Now().
+// RecordCall appends c to the log, or adds it to the running totals of a
+// Recorder returned by NewSummaryRecorder. If c.At is zero, it is set to time.Now().
 func (r *Recorder) RecordCall(c Call) {
 	if c.At.IsZero() {
 		c.At = time.Now()
 	}
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	if r.summary != nil {
+		r.summary.Add(c)
+		return
+	}
 	r.calls = append(r.calls, c)
 }
 
+// Summary returns a summary of all recorded calls.
+func (r *Recorder) Summary() *Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.summary != nil {
+		s := NewSummary()
+		s.Merge(r.summary)
+		s.sort()
+		return s
+	}
+	return Summarize(r.calls)
+}
+
 // Calls returns a snapshot of all recorded calls.
 func (r *Recorder) Calls() []Call {
 	r.mu.Lock()
@@ -113,6 +146,9 @@ func (r *Recorder) Reset() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.calls = r.calls[:0]
+	if r.summary != nil {
+		r.summary = NewSummary()
+	}
 }
 
 // Contains reports whether service.operation has been recorded.
@@ -165,6 +201,12 @@ func FromContext(ctx context.Context) (*Recorder, bool) {
 	return r, r != nil
 }
 
+// IsThrottle reports whether err, the error of a single request attempt, is a
+// throttling error. This is the definition of a throttle used for Call.Throttles.
+func IsThrottle(err error) bool {
+	return err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
 // MiddlewareID is the Smithy stack identifier of the recording middleware.
 const MiddlewareID = "TerraformProviderAWSCallRecorder"
 
@@ -186,14 +228,23 @@ func (recorderMiddleware) HandleInitialize(
 	if rec, ok := FromContext(ctx); ok {
 		end := time.Now()
 		reqID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
-		rec.RecordCall(Call{
+		c := Call{
 			Service:   awsmiddleware.GetServiceID(ctx),
 			Operation: awsmiddleware.GetOperationName(ctx),
 			Err:       err,
 			At:        end,
 			Duration:  end.Sub(start),
 			RequestID: reqID,
-		})
+		}
+		if results, ok := retry.GetAttemptResults(metadata); ok {
+			c.Attempts = len(results.Results)
+			for _, v := range results.Results {
+				if IsThrottle(v.Err) {
+					c.Throttles++
+				}
+			}
+		}
+		rec.RecordCall(c)
 	}
 
 	return out, metadata, err
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	"github.com/aws/smithy-go/middleware"
@@ -61,3 +64,59 @@ func TestEndToEnd_PinpointClient(t *testing.T) {
 		t.Errorf("Duration = %s, want > 0", calls[0].Duration)
 	}
 }
+
+// TestEndToEnd_RetriesAndThrottles verifies that the recorder captures the
+// attempt count and throttling errors reported by the SDK's retry middleware.
+func TestEndToEnd_RetriesAndThrottles(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) <= 2 {
+			w.Header().Set("X-Amzn-Errortype", "TooManyRequestsException")
+			w.WriteHeader(http.StatusTooManyRequests)
+			if _, err := fmt.Fprintln(w, `{"Message":"Rate exceeded"}`); err != nil {
+				t.Errorf("write response: %s", err)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		if _, err := fmt.Fprintln(w, `{"ApplicationResponse":{"Id":"x","Arn":"arn:aws:mobiletargeting:us-east-1:000000000000:apps/x","Name":"x"}}`); err != nil {
+			t.Errorf("write response: %s", err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	rec := apicall.NewRecorder()
+	ctx := apicall.NewContext(context.Background(), rec)
+
+	cfg := aws.Config{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		APIOptions:   []func(*middleware.Stack) error{apicall.Middleware()},
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+			})
+		},
+	}
+
+	client := pinpoint.NewFromConfig(cfg)
+	input := pinpoint.GetAppInput{ApplicationId: aws.String("x")}
+	if _, err := client.GetApp(ctx, &input); err != nil {
+		t.Fatalf("GetApp: %v", err)
+	}
+
+	calls := rec.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("len(Calls()) = %d, want 1; calls=%+v", len(calls), calls)
+	}
+	if got, want := calls[0].Attempts, 3; got != want {
+		t.Errorf("Attempts = %d, want %d", got, want)
+	}
+	if got, want := calls[0].Throttles, 2; got != want {
+		t.Errorf("Throttles = %d, want %d", got, want)
+	}
+}
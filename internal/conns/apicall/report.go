@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	reportLockRetryInterval = 10 * time.Millisecond
+	reportLockTimeout       = 30 * time.Second
+	// A lock file older than this was left by a provider process which exited
+	// without removing it.
+	reportLockStaleAge = 2 * time.Minute
+)
+
+// report is the API call report file's contents.
+type report struct {
+	// TerraformPID is the process ID of the Terraform command which ran the
+	// provider processes whose calls are in the report.
+	TerraformPID int `json:"terraform_pid"`
+	*Summary
+}
+
+// WriteReport adds s to the API call report at path.
+//
+// Terraform runs a provider process for each provider configuration, and
+// more than one for each command, for example to validate and then to
+// apply a configuration. The summaries of all provider processes run by the
+// same Terraform command are merged into a single report; a report written
+// by a previous command is replaced.
+// A lock file is used to serialize updates from concurrent provider processes.
+func WriteReport(path string, s *Summary) error {
+	return writeReport(path, s, os.Getppid())
+}
+
+func writeReport(path string, s *Summary, terraformPID int) error {
+	unlock, err := lockReport(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	merged := report{
+		TerraformPID: terraformPID,
+		Summary:      NewSummary(),
+	}
+
+	b, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("reading API call summary (%s): %w", path, err)
+	default:
+		existing := report{
+			Summary: NewSummary(),
+		}
+
+		// An unreadable report is replaced.
+		if err := json.Unmarshal(b, &existing); err == nil && existing.TerraformPID == terraformPID {
+			merged.Merge(existing.Summary)
+		}
+	}
+
+	merged.Merge(s)
+	merged.sort()
+
+	b, err = json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling API call summary: %w", err)
+	}
+
+	// Replace the report atomically, so that it is never seen partially written.
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("writing API call summary (%s): %w", path, err)
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(b)
+	if err := errors.Join(err, f.Close()); err != nil {
+		return fmt.Errorf("writing API call summary (%s): %w", path, err)
+	}
+
+	if err := os.Chmod(f.Name(), 0644); err != nil { //nolint:mnd
+		return fmt.Errorf("writing API call summary (%s): %w", path, err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("writing API call summary (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// lockReport creates the report's lock file, waiting for any other provider
+// process holding it. The returned function removes the lock file.
+func lockReport(path string) (func(), error) {
+	name := path + ".lock"
+	deadline := time.Now().Add(reportLockTimeout)
+
+	for {
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644) //nolint:mnd
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(name) }, nil
+		}
+
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("locking API call summary (%s): %w", path, err)
+		}
+
+		if fi, err := os.Stat(name); err == nil && time.Since(fi.ModTime()) > reportLockStaleAge {
+			os.Remove(name)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("locking API call summary (%s): timed out waiting for %s", path, name)
+		}
+
+		time.Sleep(reportLockRetryInterval)
+	}
+}
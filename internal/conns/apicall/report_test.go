@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func readReport(t *testing.T, path string) report {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	r := report{
+		Summary: NewSummary(),
+	}
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	return r
+}
+
+func TestWriteReport(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "api-calls.json")
+	summary := func(operation string) *Summary {
+		return Summarize([]Call{{Service: "EC2", Operation: operation, Duration: time.Second, Attempts: 1}})
+	}
+
+	// Provider processes run by the same Terraform command, for example aliased providers.
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			if err := writeReport(path, summary("DescribeVpcs"), 100); err != nil {
+				t.Errorf("writeReport: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if err := writeReport(path, summary("DescribeSubnets"), 100); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+
+	r := readReport(t, path)
+	if got, want := r.TerraformPID, 100; got != want {
+		t.Errorf("TerraformPID = %d, want %d", got, want)
+	}
+	if got, want := r.Calls, 9; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := len(r.Operations), 2; got != want {
+		t.Fatalf("len(Operations) = %d, want %d", got, want)
+	}
+	if got, want := r.Operations[0].Calls, 8; got != want {
+		t.Errorf("Operations[0].Calls = %d, want %d", got, want)
+	}
+
+	// A later Terraform command replaces the report.
+	if err := writeReport(path, summary("DescribeVpcs"), 200); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+
+	r = readReport(t, path)
+	if got, want := r.TerraformPID, 200; got != want {
+		t.Errorf("TerraformPID = %d, want %d", got, want)
+	}
+	if got, want := r.Calls, 1; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file not removed: %v", err)
+	}
+}
+
+func TestWriteReport_staleLock(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "api-calls.json")
+	lock := path + ".lock"
+	if err := os.WriteFile(lock, nil, 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	stale := time.Now().Add(-2 * reportLockStaleAge)
+	if err := os.Chtimes(lock, stale, stale); err != nil {
+		t.Fatalf("Chtimes: %v", err)
+	}
+
+	if err := writeReport(path, Summarize([]Call{{Service: "S3", Operation: "GetObject"}}), 100); err != nil {
+		t.Fatalf("writeReport: %v", err)
+	}
+
+	if got, want := readReport(t, path).Calls, 1; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+}
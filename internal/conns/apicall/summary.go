@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// ReportPathEnvVar is the environment variable which sets the path of the API call summary report
+// written by the provider, when not set in the provider configuration.
+const ReportPathEnvVar = "TF_AWS_API_CALL_REPORT_PATH"
+
+// Totals holds counters aggregated over a set of calls.
+type Totals struct {
+	Calls          int     `json:"calls"`
+	Retries        int     `json:"retries"`
+	Throttles      int     `json:"throttles"`
+	Errors         int     `json:"errors"`
+	LatencySeconds float64 `json:"latency_seconds"`
+}
+
+func (t *Totals) add(c Call) {
+	t.Calls++
+	t.Retries += max(c.Attempts-1, 0)
+	t.Throttles += c.Throttles
+	if c.Err != nil {
+		t.Errors++
+	}
+	t.LatencySeconds += c.Duration.Seconds()
+}
+
+func (t *Totals) merge(o Totals) {
+	t.Calls += o.Calls
+	t.Retries += o.Retries
+	t.Throttles += o.Throttles
+	t.Errors += o.Errors
+	t.LatencySeconds += o.LatencySeconds
+}
+
+// OperationSummary aggregates the calls made to a single service operation.
+type OperationSummary struct {
+	Service           string  `json:"service"`
+	Operation         string  `json:"operation"`
+	MaxLatencySeconds float64 `json:"max_latency_seconds"`
+	Totals
+}
+
+// Summary aggregates a Recorder's call log, for example to find which
+// operations contribute most to a slow Terraform run.
+// A Summary is not safe for concurrent use.
+type Summary struct {
+	FirstCallAt time.Time           `json:"first_call_at"`
+	LastCallAt  time.Time           `json:"last_call_at"`
+	Operations  []*OperationSummary `json:"operations"`
+	Totals
+
+	operations map[[2]string]*OperationSummary // Service and operation -> summary. Built on first use.
+}
+
+// NewSummary returns an empty Summary.
+func NewSummary() *Summary {
+	return &Summary{
+		Operations: make([]*OperationSummary, 0),
+	}
+}
+
+// Summarize aggregates calls by service and operation.
+// Operations are ordered by descending call count, then by total latency.
+func Summarize(calls []Call) *Summary {
+	s := NewSummary()
+
+	for _, c := range calls {
+		s.Add(c)
+	}
+	s.sort()
+
+	return s
+}
+
+// Add adds a call to the summary's running totals.
+func (s *Summary) Add(c Call) {
+	op := s.operation(c.Service, c.Operation)
+	op.add(c)
+	op.MaxLatencySeconds = max(op.MaxLatencySeconds, c.Duration.Seconds())
+	s.add(c)
+
+	// At is set when the call returns.
+	s.span(c.At.Add(-c.Duration), c.At)
+}
+
+// Merge adds the totals of another summary, for example one written by another provider process.
+func (s *Summary) Merge(o *Summary) {
+	for _, v := range o.Operations {
+		op := s.operation(v.Service, v.Operation)
+		op.merge(v.Totals)
+		op.MaxLatencySeconds = max(op.MaxLatencySeconds, v.MaxLatencySeconds)
+	}
+	s.merge(o.Totals)
+
+	if o.Calls > 0 {
+		s.span(o.FirstCallAt, o.LastCallAt)
+	}
+}
+
+func (s *Summary) operation(service, operation string) *OperationSummary {
+	if s.operations == nil {
+		s.operations = make(map[[2]string]*OperationSummary, len(s.Operations))
+		for _, v := range s.Operations {
+			s.operations[[2]string{v.Service, v.Operation}] = v
+		}
+	}
+
+	key := [2]string{service, operation}
+	op, ok := s.operations[key]
+	if !ok {
+		op = &OperationSummary{
+			Service:   service,
+			Operation: operation,
+		}
+		s.operations[key] = op
+		s.Operations = append(s.Operations, op)
+	}
+
+	return op
+}
+
+func (s *Summary) span(first, last time.Time) {
+	if s.FirstCallAt.IsZero() || first.Before(s.FirstCallAt) {
+		s.FirstCallAt = first
+	}
+	if last.After(s.LastCallAt) {
+		s.LastCallAt = last
+	}
+}
+
+func (s *Summary) sort() {
+	slices.SortFunc(s.Operations, func(a, b *OperationSummary) int {
+		return cmp.Or(
+			cmp.Compare(b.Calls, a.Calls),
+			cmp.Compare(b.LatencySeconds, a.LatencySeconds),
+			cmp.Compare(a.Service, b.Service),
+			cmp.Compare(a.Operation, b.Operation),
+		)
+	})
+}
+
+// WriteFile writes the summary as JSON to the specified path.
+func (s *Summary) WriteFile(path string) error {
+	s.sort()
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling API call summary: %w", err)
+	}
+
+	if err := os.WriteFile(path, b, 0644); err != nil { //nolint:mnd
+		return fmt.Errorf("writing API call summary (%s): %w", path, err)
+	}
+
+	return nil
+}
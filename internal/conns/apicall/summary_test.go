@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	calls := []Call{
+		{Service: "EC2", Operation: "DescribeVpcs", At: at.Add(1 * time.Second), Duration: 100 * time.Millisecond, Attempts: 1},
+		{Service: "EC2", Operation: "DescribeVpcs", At: at.Add(2 * time.Second), Duration: 300 * time.Millisecond, Attempts: 3, Throttles: 2},
+		{Service: "EC2", Operation: "DescribeVpcs", At: at.Add(3 * time.Second), Duration: 200 * time.Millisecond, Attempts: 1, Err: errors.New("boom")},
+		{Service: "Route 53", Operation: "ChangeResourceRecordSets", At: at.Add(4 * time.Second), Duration: 2 * time.Second, Attempts: 2, Throttles: 1},
+		{Service: "IAM", Operation: "GetRole", At: at.Add(500 * time.Millisecond), Duration: 500 * time.Millisecond},
+	}
+
+	s := Summarize(calls)
+
+	got := s.Totals
+	got.LatencySeconds = 0 // Floating point sum.
+	if want := (Totals{Calls: 5, Retries: 3, Throttles: 3, Errors: 1}); got != want {
+		t.Errorf("Totals = %+v, want %+v", got, want)
+	}
+	if got, want := s.FirstCallAt, at; !got.Equal(want) {
+		t.Errorf("FirstCallAt = %s, want %s", got, want)
+	}
+	if got, want := s.LastCallAt, at.Add(4*time.Second); !got.Equal(want) {
+		t.Errorf("LastCallAt = %s, want %s", got, want)
+	}
+
+	if got, want := len(s.Operations), 3; got != want {
+		t.Fatalf("len(Operations) = %d, want %d", got, want)
+	}
+
+	// Ordered by call count, then by latency.
+	wantOrder := [][2]string{
+		{"EC2", "DescribeVpcs"},
+		{"Route 53", "ChangeResourceRecordSets"},
+		{"IAM", "GetRole"},
+	}
+	for i, want := range wantOrder {
+		if got := [2]string{s.Operations[i].Service, s.Operations[i].Operation}; got != want {
+			t.Errorf("Operations[%d] = %v, want %v", i, got, want)
+		}
+	}
+
+	op := s.Operations[0]
+	if got, want := op.Calls, 3; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := op.Retries, 2; got != want {
+		t.Errorf("Retries = %d, want %d", got, want)
+	}
+	if got, want := op.Throttles, 2; got != want {
+		t.Errorf("Throttles = %d, want %d", got, want)
+	}
+	if got, want := op.Errors, 1; got != want {
+		t.Errorf("Errors = %d, want %d", got, want)
+	}
+	if got, want := op.MaxLatencySeconds, 0.3; got != want {
+		t.Errorf("MaxLatencySeconds = %v, want %v", got, want)
+	}
+}
+
+func TestSummarize_NoCalls(t *testing.T) {
+	t.Parallel()
+
+	s := Summarize(nil)
+
+	if got, want := s.Calls, 0; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if s.Operations == nil {
+		t.Error("Operations is nil, want empty")
+	}
+}
+
+func TestSummary_WriteFile(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	r.RecordCall(Call{Service: "S3", Operation: "GetObject", Duration: time.Second, Attempts: 1})
+
+	path := filepath.Join(t.TempDir(), "api-calls.json")
+	if err := Summarize(r.Calls()).WriteFile(path); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if got, want := got["calls"], 1.0; got != want {
+		t.Errorf("calls = %v, want %v", got, want)
+	}
+	operations, ok := got["operations"].([]any)
+	if !ok || len(operations) != 1 {
+		t.Fatalf("operations = %v, want 1 operation", got["operations"])
+	}
+	if got, want := operations[0].(map[string]any)["operation"], "GetObject"; got != want {
+		t.Errorf("operation = %v, want %v", got, want)
+	}
+}
+
+func TestSummary_Merge(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s1 := Summarize([]Call{
+		{Service: "EC2", Operation: "DescribeVpcs", At: at.Add(2 * time.Second), Duration: time.Second, Attempts: 2, Throttles: 1},
+	})
+	s2 := Summarize([]Call{
+		{Service: "EC2", Operation: "DescribeVpcs", At: at.Add(5 * time.Second), Duration: 3 * time.Second, Attempts: 1},
+		{Service: "IAM", Operation: "GetRole", At: at.Add(10 * time.Second), Duration: time.Second, Err: errors.New("boom")},
+	})
+
+	s := NewSummary()
+	s.Merge(s1)
+	s.Merge(s2)
+	s.Merge(NewSummary())
+	s.sort()
+
+	if want := (Totals{Calls: 3, Retries: 1, Throttles: 1, Errors: 1, LatencySeconds: 5}); s.Totals != want {
+		t.Errorf("Totals = %+v, want %+v", s.Totals, want)
+	}
+	if got, want := s.FirstCallAt, at.Add(time.Second); !got.Equal(want) {
+		t.Errorf("FirstCallAt = %s, want %s", got, want)
+	}
+	if got, want := s.LastCallAt, at.Add(10*time.Second); !got.Equal(want) {
+		t.Errorf("LastCallAt = %s, want %s", got, want)
+	}
+	if got, want := len(s.Operations), 2; got != want {
+		t.Fatalf("len(Operations) = %d, want %d", got, want)
+	}
+	if got, want := *s.Operations[0], (OperationSummary{Service: "EC2", Operation: "DescribeVpcs", MaxLatencySeconds: 3, Totals: Totals{Calls: 2, Retries: 1, Throttles: 1, LatencySeconds: 4}}); got != want {
+		t.Errorf("Operations[0] = %+v, want %+v", got, want)
+	}
+}
+
+func TestSummaryRecorder(t *testing.T) {
+	t.Parallel()
+
+	r := NewSummaryRecorder()
+	r.RecordCall(Call{Service: "S3", Operation: "GetObject", Duration: time.Second, Attempts: 1})
+	r.RecordCall(Call{Service: "S3", Operation: "GetObject", Duration: time.Second, Attempts: 3})
+
+	if got := r.Calls(); len(got) != 0 {
+		t.Errorf("Calls() = %v, want none", got)
+	}
+
+	s := r.Summary()
+	if got, want := s.Calls, 2; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := s.Retries, 2; got != want {
+		t.Errorf("Retries = %d, want %d", got, want)
+	}
+
+	// The summary returned is a snapshot.
+	r.RecordCall(Call{Service: "S3", Operation: "PutObject"})
+	if got, want := s.Calls, 2; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+	if got, want := r.Summary().Calls, 3; got != want {
+		t.Errorf("Calls = %d, want %d", got, want)
+	}
+}
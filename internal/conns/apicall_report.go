@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+)
+
+// apiCallReports holds the API call recorders for provider instances configured with an API call report path.
+// Provider instances configured with the same path share a recorder.
+var apiCallReports = struct {
+	mu        sync.Mutex
+	recorders map[string]*apicall.Recorder // Report path -> recorder.
+}{
+	recorders: make(map[string]*apicall.Recorder),
+}
+
+func apiCallReportRecorder(path string) *apicall.Recorder {
+	apiCallReports.mu.Lock()
+	defer apiCallReports.mu.Unlock()
+
+	r, ok := apiCallReports.recorders[path]
+	if !ok {
+		r = apicall.NewSummaryRecorder()
+		apiCallReports.recorders[path] = r
+	}
+
+	return r
+}
+
+// WriteAPICallReports adds a summary of the AWS API calls recorded for each configured API call report path
+// to the report at that path, which is shared by all provider processes run by the same Terraform command.
+// Paths for which no calls were recorded are not written.
+// It should be called once, as the provider process exits.
+func WriteAPICallReports() error {
+	apiCallReports.mu.Lock()
+	defer apiCallReports.mu.Unlock()
+
+	var errs []error
+
+	for _, path := range slices.Sorted(maps.Keys(apiCallReports.recorders)) {
+		summary := apiCallReports.recorders[path].Summary()
+		if summary.Calls == 0 {
+			continue
+		}
+
+		if err := apicall.WriteReport(path, summary); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
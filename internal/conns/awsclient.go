@@ -11,6 +11,7 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -18,9 +19,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -35,7 +38,7 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
-	callRecorder              *apicall.Recorder         // For acceptance tests asserting which AWS API operations are made.
+	callRecorder              *apicall.Recorder         // For acceptance tests asserting which AWS API operations are made, or API call reports.
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
@@ -44,7 +47,8 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	randomnessSource          rand.Source                    // For VCR deterministic randomness.
+	rateLimiters              map[string]*serviceRateLimiter // Service package name -> rate limiter. From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -53,6 +57,7 @@ type AWSClient struct {
 	stsRegion                 string // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
 	terraformVersion          string // From provider configuration.
+	zeroWaitDelay             bool   // For acceptance tests against an in-memory AWS backend.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -126,6 +131,9 @@ func (c *AWSClient) SetRandomnessSource(source rand.Source) {
 // SDKv2 and Plugin Framework wrappers plumb a non-nil recorder onto the
 // per-resource request context, where the apicall middleware (registered
 // once on the base aws.Config) finds it and records each AWS SDK operation.
+//
+// A recorder is attached either for acceptance testing or, when an API call
+// report path is configured, to produce the summary written by [WriteAPICallReports].
 func (c *AWSClient) CallRecorder() *apicall.Recorder {
 	return c.callRecorder
 }
@@ -135,6 +143,26 @@ func (c *AWSClient) SetCallRecorder(r *apicall.Recorder) {
 	c.callRecorder = r
 }
 
+// AppendAPIOptions appends Smithy middleware stack mutators to the base AWS SDK for Go v2
+// configuration and discards any cached API clients so that they pick up the change.
+// Acceptance test and sweeper setup only.
+func (c *AWSClient) AppendAPIOptions(_ context.Context, optFns ...func(*middleware.Stack) error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.awsConfig == nil {
+		return
+	}
+
+	c.awsConfig.APIOptions = append(slices.Clone(c.awsConfig.APIOptions), optFns...)
+	c.clients = make(map[string]map[string]any, 0)
+}
+
+// SetZeroWaitDelay disables the delay between state change refreshes. Acceptance test setup only.
+func (c *AWSClient) SetZeroWaitDelay(v bool) {
+	c.zeroWaitDelay = v
+}
+
 // RequestContext augments ctx with the per-request observability and
 // configuration values that every framework- and SDKv2-managed AWS API
 // call needs. This is the single point where these are wired; new
@@ -146,6 +174,7 @@ func (c *AWSClient) SetCallRecorder(r *apicall.Recorder) {
 //   - the AWS client logger
 //   - the VCR randomness source, when VCR testing is active
 //   - the API-call recorder, when one is attached for the test
+//   - the zero wait delay marker, when testing against an in-memory AWS backend
 //   - the AutoFlex logger
 //
 // Each element is a no-op when the corresponding feature is inactive.
@@ -166,6 +195,9 @@ func (c *AWSClient) RequestContext(ctx context.Context) context.Context {
 		ctx = vcr.NewContext(ctx, s)
 	}
 	ctx = apicall.NewContext(ctx, c.callRecorder)
+	if c.zeroWaitDelay {
+		ctx = backoff.NewZeroDelayContext(ctx)
+	}
 	return fwflex.RegisterLogger(ctx)
 }
 
@@ -373,6 +405,11 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 	return dns.Reverse(c.DNSSuffix(ctx))
 }
 
+// ServicePrincipalName returns the service principal name of the specified service in the configured AWS partition.
+func (c *AWSClient) ServicePrincipalName(_ context.Context, service string) string {
+	return service + "." + inttypes.ServicePrincipalSuffixForPartition(service, c.partition)
+}
+
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
 	region := c.Region(ctx)
@@ -444,6 +481,12 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if l, ok := c.rateLimiters[servicePackageName]; ok && c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), rateLimitMiddlewareStackMutator(l))
+		m["aws_sdkv2_config"] = &cfg
+	}
+
 	return m
 }
 
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewUnconfiguredAWSClient returns an AWS client for the specified Region that has no credentials.
+// Only methods that derive values from the Region and its partition, for example DNSSuffix and ResolveEndpoint, are usable.
+func NewUnconfiguredAWSClient(region string, servicePackages map[string]ServicePackage) *AWSClient {
+	return &AWSClient{
+		awsConfig: &aws.Config{
+			Region: region,
+			Retryer: func() aws.Retryer {
+				return retry.NewStandard()
+			},
+		},
+		clients:         make(map[string]map[string]any),
+		endpoints:       make(map[string]string),
+		partition:       names.PartitionForRegion(region),
+		servicePackages: maps.Clone(servicePackages),
+	}
+}
+
+// ResolveEndpoint returns the endpoint URL that the specified service package's AWS API client uses in the configured AWS Region.
+// The endpoint is resolved by the client's endpoint resolver without calling AWS.
+func (c *AWSClient) ResolveEndpoint(ctx context.Context, servicePackageName string, useFIPS, useDualStack bool) (string, error) {
+	sp := c.ServicePackage(ctx, servicePackageName)
+	if sp == nil {
+		return "", fmt.Errorf("unknown service package: %s", servicePackageName)
+	}
+
+	// Each service package's NewClient method returns a different AWS SDK for Go v2 API client type.
+	newClient := reflect.ValueOf(sp).MethodByName("NewClient")
+	if !newClient.IsValid() {
+		return "", fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
+	}
+
+	out := newClient.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(c.apiClientConfig(ctx, servicePackageName))})
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return "", err
+	}
+
+	options := out[0].MethodByName("Options").Call(nil)[0]
+	resolver := options.FieldByName("EndpointResolverV2")
+	if !resolver.IsValid() || resolver.IsNil() {
+		return "", fmt.Errorf("no AWS SDK v2 API endpoint resolver: %s", servicePackageName)
+	}
+
+	resolveEndpoint := resolver.MethodByName("ResolveEndpoint")
+	params := reflect.New(resolveEndpoint.Type().In(1)).Elem()
+	// Use the client's Region, which a service package may override.
+	setEndpointParameter(params, "Region", aws.String(options.FieldByName("Region").String()))
+	setEndpointParameter(params, "UseFIPS", aws.Bool(useFIPS))
+	setEndpointParameter(params, "UseDualStack", aws.Bool(useDualStack))
+
+	out = resolveEndpoint.Call([]reflect.Value{reflect.ValueOf(ctx), params})
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return "", fmt.Errorf("resolving %s endpoint: %w", servicePackageName, err)
+	}
+
+	endpoint := out[0].Interface().(smithyendpoints.Endpoint)
+
+	return endpoint.URI.String(), nil
+}
+
+// setEndpointParameter sets the named field of an AWS SDK for Go v2 EndpointParameters struct, if the service has that parameter.
+func setEndpointParameter(params reflect.Value, name string, value any) {
+	if field := params.FieldByName(name); field.IsValid() && field.CanSet() && field.Type() == reflect.TypeOf(value) {
+		field.Set(reflect.ValueOf(value))
+	}
+}
@@ -30,6 +30,7 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APICallReportPath              string
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
@@ -47,6 +48,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	RateLimits                     []RateLimit
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
@@ -213,7 +215,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	if c.TagPolicyConfig != nil && c.TagPolicyConfig.File != "" {
+		tflog.Debug(ctx, "Reading tag policy file", map[string]any{
+			"path": c.TagPolicyConfig.File,
+		})
+		reqTags, tagRules, err := tagpolicy.ReadFile(ctx, c.TagPolicyConfig.File)
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy File",
+				fmt.Sprintf("Failed to read the tag policy file.\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+		c.TagPolicyConfig.RequiredTags = reqTags
+		c.TagPolicyConfig.TagRules = tagRules
+	} else if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -225,6 +240,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			return nil, diags
 		}
 		c.TagPolicyConfig.RequiredTags = reqTags
+
+		tflog.Debug(ctx, "Retrieving tag policy rules")
+		tagRules, err := tagpolicy.GetTagRules(ctx, cfg)
+		if err != nil {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"Retrieving Tag Policy Rules",
+				`Failed to retrieve the effective tag policy. Tag values will not be validated against the tag policy. `+
+					`Ensure the calling principal has the "organizations:DescribeEffectivePolicy" IAM permission.`+
+					fmt.Sprintf("\n\nOriginal error: %s", err)))
+		}
+		c.TagPolicyConfig.TagRules = tagRules
 	}
 
 	client.accountID = accountID
@@ -239,11 +265,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	// *apicall.Recorder is attached to the request context.
 	cfg.APIOptions = append(cfg.APIOptions, apicall.Middleware())
 
+	rateLimiters, err := newRateLimiters(c.RateLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Record every AWS API call for the summary report written as the provider exits.
+	// A recorder attached for acceptance testing takes precedence.
+	if c.APICallReportPath != "" && client.callRecorder == nil {
+		client.callRecorder = apiCallReportRecorder(c.APICallReportPath)
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.rateLimiters = rateLimiters
 	client.s3OriginalRegion = c.S3OriginalRegion
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
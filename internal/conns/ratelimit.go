@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// RateLimit configures client-side rate limiting of AWS API requests made to a
+// service, or to a single operation of a service.
+type RateLimit struct {
+	Adaptive          bool    // Reduce the request rate when throttling errors are returned.
+	Burst             int     // Maximum number of requests made without waiting. Defaults to the request rate, rounded up.
+	Operation         string  // API operation name, e.g. "ChangeResourceRecordSets". Empty for all of the service's operations.
+	RequestsPerSecond float64 // Maximum sustained request rate.
+	Service           string  // Service package name, e.g. "route53".
+}
+
+const (
+	adaptiveBackoffFactor  = 0.5  // Multiplier applied to the request rate on each throttling error.
+	adaptiveMinRateFactor  = 0.05 // Lower bound of the request rate, as a fraction of the configured rate.
+	adaptiveRecoveryFactor = 0.05 // Fraction of the configured rate restored on each successful request.
+)
+
+// tokenBucket is a token bucket rate limiter whose rate can be adjusted in response to throttling.
+// Safe for concurrent use.
+type tokenBucket struct {
+	adaptive bool
+	burst    float64
+	last     time.Time
+	maxRate  float64
+	minRate  float64
+	mu       sync.Mutex
+	now      func() time.Time
+	rate     float64
+	tokens   float64
+}
+
+func newTokenBucket(v RateLimit) *tokenBucket {
+	burst := float64(v.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(v.RequestsPerSecond))
+	}
+
+	return &tokenBucket{
+		adaptive: v.Adaptive,
+		burst:    burst,
+		maxRate:  v.RequestsPerSecond,
+		minRate:  v.RequestsPerSecond * adaptiveMinRateFactor,
+		now:      time.Now,
+		rate:     v.RequestsPerSecond,
+		tokens:   burst,
+	}
+}
+
+// refill adds the tokens accumulated since the last call. The caller must hold the lock.
+func (b *tokenBucket) refill() {
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve()
+	if d == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttled reduces the request rate after a throttling error.
+func (b *tokenBucket) throttled() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.rate = math.Max(b.minRate, b.rate*adaptiveBackoffFactor)
+}
+
+// succeeded restores part of the configured request rate after a successful request.
+func (b *tokenBucket) succeeded() {
+	if !b.adaptive {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill()
+	b.rate = math.Min(b.maxRate, b.rate+b.maxRate*adaptiveRecoveryFactor)
+}
+
+// serviceRateLimiter holds the token buckets configured for a single service.
+type serviceRateLimiter struct {
+	operations map[string]*tokenBucket // Operation name -> token bucket.
+	service    *tokenBucket            // Applies to all of the service's operations. May be nil.
+}
+
+func (l *serviceRateLimiter) buckets(operation string) []*tokenBucket {
+	var buckets []*tokenBucket
+
+	if v, ok := l.operations[operation]; ok {
+		buckets = append(buckets, v)
+	}
+	if l.service != nil {
+		buckets = append(buckets, l.service)
+	}
+
+	return buckets
+}
+
+// newRateLimiters returns the rate limiters for the specified configuration, keyed by service package name.
+func newRateLimiters(rateLimits []RateLimit) (map[string]*serviceRateLimiter, error) {
+	limiters := make(map[string]*serviceRateLimiter)
+	servicePackageNames := names.ProviderPackages()
+
+	for _, v := range rateLimits {
+		// Accept any of the names that the provider's endpoints configuration block accepts.
+		if !slices.Contains(servicePackageNames, v.Service) {
+			servicePackageName, err := names.ProviderPackageForAlias(v.Service)
+			if err != nil {
+				return nil, fmt.Errorf("rate limit: unknown service %q", v.Service)
+			}
+			v.Service = servicePackageName
+		}
+		if v.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("rate limit (%s): requests per second must be greater than 0", v.Service)
+		}
+		if v.Burst < 0 {
+			return nil, fmt.Errorf("rate limit (%s): burst must not be negative", v.Service)
+		}
+
+		l, ok := limiters[v.Service]
+		if !ok {
+			l = &serviceRateLimiter{
+				operations: make(map[string]*tokenBucket),
+			}
+			limiters[v.Service] = l
+		}
+
+		if v.Operation == "" {
+			if l.service != nil {
+				return nil, fmt.Errorf("rate limit (%s): duplicate configuration", v.Service)
+			}
+			l.service = newTokenBucket(v)
+		} else {
+			if _, ok := l.operations[v.Operation]; ok {
+				return nil, fmt.Errorf("rate limit (%s.%s): duplicate configuration", v.Service, v.Operation)
+			}
+			l.operations[v.Operation] = newTokenBucket(v)
+		}
+	}
+
+	return limiters, nil
+}
+
+// rateLimitMiddlewareID is the Smithy stack identifier of the rate limiting middleware.
+const rateLimitMiddlewareID = "TerraformProviderAWSRateLimiter"
+
+// rateLimitMiddleware waits for a token before each request attempt.
+// Runs at Finalize, after the Retry middleware, so that retried attempts are also rate limited.
+type rateLimitMiddleware struct {
+	limiter *serviceRateLimiter
+}
+
+func (rateLimitMiddleware) ID() string { return rateLimitMiddlewareID }
+
+func (m rateLimitMiddleware) HandleFinalize(
+	ctx context.Context,
+	in middleware.FinalizeInput,
+	next middleware.FinalizeHandler,
+) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	for _, b := range m.limiter.buckets(awsmiddleware.GetOperationName(ctx)) {
+		if err := b.wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+	}
+
+	return next.HandleFinalize(ctx, in)
+}
+
+// adaptiveRateLimitMiddlewareID is the Smithy stack identifier of the adaptive rate limiting middleware.
+const adaptiveRateLimitMiddlewareID = "TerraformProviderAWSAdaptiveRateLimiter"
+
+// adaptiveRateLimitMiddleware adjusts the request rate from the outcome of each request attempt.
+// Runs at Finalize, before the Retry middleware, so that it sees the attempt results of the whole operation.
+// Attempts are classified as throttled by apicall.IsThrottle, as for API call reports.
+type adaptiveRateLimitMiddleware struct {
+	limiter *serviceRateLimiter
+}
+
+func (adaptiveRateLimitMiddleware) ID() string { return adaptiveRateLimitMiddlewareID }
+
+func (m adaptiveRateLimitMiddleware) HandleFinalize(
+	ctx context.Context,
+	in middleware.FinalizeInput,
+	next middleware.FinalizeHandler,
+) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		buckets := m.limiter.buckets(awsmiddleware.GetOperationName(ctx))
+
+		for _, v := range results.Results {
+			switch {
+			case v.Err == nil:
+				for _, b := range buckets {
+					b.succeeded()
+				}
+			case apicall.IsThrottle(v.Err):
+				for _, b := range buckets {
+					b.throttled()
+				}
+			}
+		}
+	}
+
+	return out, metadata, err
+}
+
+// rateLimitMiddlewareStackMutator returns a stack mutator that registers the rate limiting middleware on a Smithy stack.
+func rateLimitMiddlewareStackMutator(l *serviceRateLimiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		m := rateLimitMiddleware{limiter: l}
+
+		if _, ok := stack.Finalize.Get("Retry"); !ok {
+			return stack.Finalize.Add(m, middleware.Before)
+		}
+
+		if err := stack.Finalize.Insert(m, "Retry", middleware.After); err != nil {
+			return err
+		}
+
+		return stack.Finalize.Insert(adaptiveRateLimitMiddleware{limiter: l}, "Retry", middleware.Before)
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestTokenBucket(v RateLimit) (*tokenBucket, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := newTokenBucket(v)
+	b.now = clock.now
+
+	return b, clock
+}
+
+// operationContext returns a context with the specified operation name, as set by the AWS SDK for Go v2.
+func operationContext(t *testing.T, operation string) context.Context {
+	t.Helper()
+
+	var ctx context.Context
+	next := middleware.InitializeHandlerFunc(func(c context.Context, _ middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		ctx = c
+		return middleware.InitializeOutput{}, middleware.Metadata{}, nil
+	})
+
+	if _, _, err := (awsmiddleware.RegisterServiceMetadata{OperationName: operation}).HandleInitialize(context.Background(), middleware.InitializeInput{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return ctx
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	b, clock := newTestTokenBucket(RateLimit{RequestsPerSecond: 2, Burst: 2})
+
+	for i := range 2 {
+		if got := b.reserve(); got != 0 {
+			t.Errorf("reserve %d: got %s, want 0", i, got)
+		}
+	}
+
+	if got, want := b.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("reserve: got %s, want %s", got, want)
+	}
+
+	clock.advance(1500 * time.Millisecond)
+
+	// 3 tokens accumulated, less the 1 borrowed, capped at burst.
+	if got := b.reserve(); got != 0 {
+		t.Errorf("reserve after refill: got %s, want 0", got)
+	}
+	if got := b.reserve(); got != 0 {
+		t.Errorf("reserve after refill: got %s, want 0", got)
+	}
+	if got, want := b.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("reserve after refill: got %s, want %s", got, want)
+	}
+}
+
+func TestTokenBucketDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rate float64
+		want float64
+	}{
+		"fractional": {
+			rate: 0.5,
+			want: 1,
+		},
+		"rounded up": {
+			rate: 2.5,
+			want: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := newTokenBucket(RateLimit{RequestsPerSecond: testCase.rate})
+
+			if got := b.burst; got != testCase.want {
+				t.Errorf("burst: got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestTokenBucketAdaptive(t *testing.T) {
+	t.Parallel()
+
+	b, _ := newTestTokenBucket(RateLimit{RequestsPerSecond: 10, Adaptive: true})
+
+	b.throttled()
+	if got, want := b.rate, 5.0; got != want {
+		t.Errorf("rate after throttle: got %v, want %v", got, want)
+	}
+
+	for range 10 {
+		b.throttled()
+	}
+	if got, want := b.rate, 0.5; got != want {
+		t.Errorf("rate after repeated throttles: got %v, want %v", got, want)
+	}
+
+	b.succeeded()
+	if got, want := b.rate, 1.0; got != want {
+		t.Errorf("rate after success: got %v, want %v", got, want)
+	}
+
+	for range 100 {
+		b.succeeded()
+	}
+	if got, want := b.rate, 10.0; got != want {
+		t.Errorf("rate after repeated successes: got %v, want %v", got, want)
+	}
+}
+
+func TestTokenBucketNotAdaptive(t *testing.T) {
+	t.Parallel()
+
+	b, _ := newTestTokenBucket(RateLimit{RequestsPerSecond: 10})
+
+	b.throttled()
+	if got, want := b.rate, 10.0; got != want {
+		t.Errorf("rate after throttle: got %v, want %v", got, want)
+	}
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rateLimits   []RateLimit
+		expectError  bool
+		wantServices []string
+	}{
+		"none": {},
+		"service and operations": {
+			rateLimits: []RateLimit{
+				{Service: names.Route53, RequestsPerSecond: 5},
+				{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 1},
+				{Service: names.IAM, Operation: "AttachRolePolicy", RequestsPerSecond: 2, Adaptive: true},
+			},
+			wantServices: []string{names.IAM, names.Route53},
+		},
+		"service alias": {
+			rateLimits: []RateLimit{
+				{Service: "eventbridge", RequestsPerSecond: 5},
+				{Service: "cloudwatchevents", Operation: "PutRule", RequestsPerSecond: 1},
+			},
+			wantServices: []string{names.Events},
+		},
+		"duplicate service alias": {
+			rateLimits: []RateLimit{
+				{Service: names.Events, RequestsPerSecond: 5},
+				{Service: "eventbridge", RequestsPerSecond: 10},
+			},
+			expectError: true,
+		},
+		"unknown service": {
+			rateLimits: []RateLimit{
+				{Service: "route66", RequestsPerSecond: 5},
+			},
+			expectError: true,
+		},
+		"zero rate": {
+			rateLimits: []RateLimit{
+				{Service: names.Route53},
+			},
+			expectError: true,
+		},
+		"negative burst": {
+			rateLimits: []RateLimit{
+				{Service: names.Route53, RequestsPerSecond: 5, Burst: -1},
+			},
+			expectError: true,
+		},
+		"duplicate service": {
+			rateLimits: []RateLimit{
+				{Service: names.Route53, RequestsPerSecond: 5},
+				{Service: names.Route53, RequestsPerSecond: 10},
+			},
+			expectError: true,
+		},
+		"duplicate operation": {
+			rateLimits: []RateLimit{
+				{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 5},
+				{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 10},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limiters, err := newRateLimiters(testCase.rateLimits)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error: got %v, want error %t", err, want)
+			}
+			if err == nil {
+				if got, want := slices.Sorted(maps.Keys(limiters)), testCase.wantServices; !slices.Equal(got, want) {
+					t.Errorf("services: got %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAdaptiveRateLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	limiters, err := newRateLimiters([]RateLimit{
+		{Service: names.Route53, RequestsPerSecond: 10, Adaptive: true},
+		{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 4, Adaptive: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	l := limiters[names.Route53]
+
+	// Attempt results are reported by the SDK's retry middleware.
+	retryer := retry.NewStandard(func(o *retry.StandardOptions) {
+		o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+	})
+	attempt := retry.NewAttemptMiddleware(retryer, func(v any) any { return v })
+
+	throttle := &smithy.GenericAPIError{Code: "Throttling"}
+	var errs []error
+	next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return attempt.HandleFinalize(ctx, in, middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			err := errs[0]
+			errs = errs[1:]
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}))
+	})
+
+	m := adaptiveRateLimitMiddleware{limiter: l}
+	ctx := operationContext(t, "ChangeResourceRecordSets")
+
+	errs = []error{throttle, throttle, nil}
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := l.operations["ChangeResourceRecordSets"].rate, 1.2; got != want {
+		t.Errorf("operation rate: got %v, want %v", got, want)
+	}
+	if got, want := l.service.rate, 3.0; got != want {
+		t.Errorf("service rate: got %v, want %v", got, want)
+	}
+
+	ctx = operationContext(t, "ListHostedZones")
+
+	errs = []error{errors.New("other")}
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := l.service.rate, 3.0; got != want {
+		t.Errorf("service rate: got %v, want %v", got, want)
+	}
+
+	errs = []error{throttle, nil}
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := l.operations["ChangeResourceRecordSets"].rate, 1.2; got != want {
+		t.Errorf("operation rate: got %v, want %v", got, want)
+	}
+	if got, want := l.service.rate, 2.0; got != want {
+		t.Errorf("service rate: got %v, want %v", got, want)
+	}
+}
+
+func TestRateLimitMiddlewareContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiters, err := newRateLimiters([]RateLimit{
+		{Service: names.Route53, RequestsPerSecond: 0.01, Burst: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var calls int
+	next := middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		calls++
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+	})
+
+	m := rateLimitMiddleware{limiter: limiters[names.Route53]}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error: got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := calls, 1; got != want {
+		t.Errorf("calls: got %d, want %d", got, want)
+	}
+}
@@ -96,6 +96,20 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to control resource sweepers
+const (
+	// When set to a truthy value, sweepers list the resources they would
+	// delete instead of deleting them
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// The maximum number of sweepers to run concurrently.
+	// Only sweepers whose dependencies have all been swept are run concurrently.
+	SweepParallelism = "TF_AWS_SWEEP_PARALLELISM"
+
+	// The path of a file to which a JSON report of swept resources is written
+	SweepReportPath = "TF_AWS_SWEEP_REPORT_PATH"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// newAWSClientForRegion returns an AWS client without credentials for the specified Region.
+// The Region's partition is determined from its name.
+func newAWSClientForRegion(region string, servicePackages iter.Seq[conns.ServicePackage]) (*conns.AWSClient, error) {
+	if !inttypes.IsAWSRegion(region) {
+		return nil, fmt.Errorf("%q is not a valid AWS Region", region)
+	}
+
+	m := make(map[string]conns.ServicePackage)
+	if servicePackages != nil {
+		for sp := range servicePackages {
+			m[sp.ServicePackageName()] = sp
+		}
+	}
+
+	return conns.NewUnconfiguredAWSClient(region, m), nil
+}
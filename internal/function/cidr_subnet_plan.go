@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// VPC subnets must be between /16 and /28.
+	cidrSubnetPlanMinPrefixLength = 16
+	cidrSubnetPlanMaxPrefixLength = 28
+	// IPv6 subnets are /64s allocated from a VPC's /56.
+	cidrSubnetPlanIPv6VPCPrefixLength    = 56
+	cidrSubnetPlanIPv6SubnetPrefixLength = 64
+)
+
+var cidrSubnetPlanTierAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var cidrSubnetPlanSubnetAttrTypes = map[string]attr.Type{
+	"cidr_block":      types.StringType,
+	"ipv6_cidr_block": types.StringType,
+}
+
+var _ function.Function = cidrSubnetPlanFunction{}
+
+func NewCIDRSubnetPlanFunction() function.Function {
+	return &cidrSubnetPlanFunction{}
+}
+
+type cidrSubnetPlanFunction struct{}
+
+func (f cidrSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnet_plan"
+}
+
+func (f cidrSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnet_plan Function",
+		MarkdownDescription: "Allocates non-overlapping subnet CIDR blocks for each tier in each Availability Zone of a VPC. " +
+			"Returns a map of tier name to a map of Availability Zone to the subnet's IPv4 and IPv6 CIDR blocks.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 CIDR block of the VPC, for example `10.0.0.0/16`",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zone names or IDs to create a subnet in for each tier",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Subnet tiers, each with a `name` and the `prefix_length` of its subnets",
+				ElementType:         types.ObjectType{AttrTypes: cidrSubnetPlanTierAttrTypes},
+			},
+			function.ListParameter{
+				Name:                "reserved_cidr_blocks",
+				MarkdownDescription: "IPv4 CIDR blocks already allocated in the VPC that subnets must not overlap",
+				ElementType:         types.StringType,
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "ipv6_cidr_block",
+				MarkdownDescription: "IPv6 /56 CIDR block of the VPC to allocate a /64 to each subnet from",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{
+				ElemType: types.ObjectType{AttrTypes: cidrSubnetPlanSubnetAttrTypes},
+			},
+		},
+	}
+}
+
+func (f cidrSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones []string
+	var tiers []cidrSubnetPlanTier
+	var reservedCIDRBlocks []string
+	var ipv6CIDRBlock types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &tiers, &reservedCIDRBlocks, &ipv6CIDRBlock))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets, err := planCIDRSubnets(cidrBlock, availabilityZones, tiers, reservedCIDRBlocks, ipv6CIDRBlock.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	plan := make(map[string]attr.Value, len(tiers))
+	for _, tier := range tiers {
+		bySubnet := make(map[string]attr.Value, len(availabilityZones))
+
+		for _, subnet := range subnets {
+			if subnet.Tier != tier.Name {
+				continue
+			}
+
+			ipv6CIDRBlock := types.StringNull()
+			if subnet.IPv6CIDRBlock != "" {
+				ipv6CIDRBlock = types.StringValue(subnet.IPv6CIDRBlock)
+			}
+
+			value, d := types.ObjectValue(cidrSubnetPlanSubnetAttrTypes, map[string]attr.Value{
+				"cidr_block":      types.StringValue(subnet.CIDRBlock),
+				"ipv6_cidr_block": ipv6CIDRBlock,
+			})
+			if d.HasError() {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+				return
+			}
+
+			bySubnet[subnet.AvailabilityZone] = value
+		}
+
+		value, d := types.MapValue(types.ObjectType{AttrTypes: cidrSubnetPlanSubnetAttrTypes}, bySubnet)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		plan[tier.Name] = value
+	}
+
+	result, d := types.MapValue(types.MapType{ElemType: types.ObjectType{AttrTypes: cidrSubnetPlanSubnetAttrTypes}}, plan)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type cidrSubnetPlanTier struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+type cidrSubnetPlanSubnet struct {
+	Tier             string
+	AvailabilityZone string
+	CIDRBlock        string
+	IPv6CIDRBlock    string
+}
+
+// planCIDRSubnets allocates a subnet for each tier in each Availability Zone, in tier order and then
+// Availability Zone order. Each subnet is placed in the lowest aligned block of the VPC CIDR block that
+// does not overlap a reserved block or a previously allocated subnet, so the plan is deterministic and
+// appending a tier does not move the subnets of earlier tiers.
+// If an IPv6 CIDR block is specified, the nth subnet allocated is assigned the nth /64 of it.
+func planCIDRSubnets(cidrBlock string, availabilityZones []string, tiers []cidrSubnetPlanTier, reservedCIDRBlocks []string, ipv6CIDRBlock string) ([]cidrSubnetPlanSubnet, error) {
+	vpc, err := parseIPv4Prefix(cidrBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(availabilityZones) == 0 {
+		return nil, errors.New("at least one Availability Zone must be specified")
+	}
+	seen := make(map[string]bool)
+	for _, v := range availabilityZones {
+		if v == "" {
+			return nil, errors.New("Availability Zone must not be empty")
+		}
+		if seen[v] {
+			return nil, fmt.Errorf("duplicate Availability Zone %q", v)
+		}
+		seen[v] = true
+	}
+
+	if len(tiers) == 0 {
+		return nil, errors.New("at least one tier must be specified")
+	}
+	seen = make(map[string]bool)
+	for _, v := range tiers {
+		if v.Name == "" {
+			return nil, errors.New("tier name must not be empty")
+		}
+		if seen[v.Name] {
+			return nil, fmt.Errorf("duplicate tier %q", v.Name)
+		}
+		seen[v.Name] = true
+
+		if v.PrefixLength < int64(max(vpc.Bits(), cidrSubnetPlanMinPrefixLength)) || v.PrefixLength > cidrSubnetPlanMaxPrefixLength {
+			return nil, fmt.Errorf("tier %q: prefix length /%d must be between /%d and /%d", v.Name, v.PrefixLength, max(vpc.Bits(), cidrSubnetPlanMinPrefixLength), cidrSubnetPlanMaxPrefixLength)
+		}
+	}
+
+	var ipv6 netip.Prefix
+	if ipv6CIDRBlock != "" {
+		if err := inttypes.ValidateIPv6CIDRBlock(ipv6CIDRBlock); err != nil {
+			return nil, err
+		}
+		ipv6 = netip.MustParsePrefix(ipv6CIDRBlock)
+
+		if ipv6.Bits() != cidrSubnetPlanIPv6VPCPrefixLength {
+			return nil, fmt.Errorf("%q is not a /%d IPv6 CIDR block", ipv6CIDRBlock, cidrSubnetPlanIPv6VPCPrefixLength)
+		}
+		if n, limit := len(tiers)*len(availabilityZones), 1<<(cidrSubnetPlanIPv6SubnetPrefixLength-cidrSubnetPlanIPv6VPCPrefixLength); n > limit {
+			return nil, fmt.Errorf("%d subnets cannot be allocated a /%d from %q, which has %d", n, cidrSubnetPlanIPv6SubnetPrefixLength, ipv6CIDRBlock, limit)
+		}
+	}
+
+	var allocated []cidrRange
+	for _, v := range reservedCIDRBlocks {
+		reserved, err := parseIPv4Prefix(v)
+		if err != nil {
+			return nil, fmt.Errorf("reserved CIDR block: %w", err)
+		}
+		allocated = append(allocated, newCIDRRange(reserved))
+	}
+
+	vpcRange := newCIDRRange(vpc)
+	var subnets []cidrSubnetPlanSubnet
+	for _, tier := range tiers {
+		size := uint64(1) << (32 - tier.PrefixLength)
+
+		for _, availabilityZone := range availabilityZones {
+			subnet, ok := allocateCIDRRange(vpcRange, size, allocated)
+			if !ok {
+				return nil, fmt.Errorf("tier %q: no free /%d in %q for Availability Zone %q", tier.Name, tier.PrefixLength, cidrBlock, availabilityZone)
+			}
+			allocated = append(allocated, subnet)
+
+			v := cidrSubnetPlanSubnet{
+				Tier:             tier.Name,
+				AvailabilityZone: availabilityZone,
+				CIDRBlock:        subnet.prefix(int(tier.PrefixLength)).String(),
+			}
+			if ipv6.IsValid() {
+				v.IPv6CIDRBlock = ipv6SubnetPrefix(ipv6, len(subnets)).String()
+			}
+
+			subnets = append(subnets, v)
+		}
+	}
+
+	return subnets, nil
+}
+
+func parseIPv4Prefix(cidr string) (netip.Prefix, error) {
+	if err := inttypes.ValidateIPv4CIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	// Reject IPv4-mapped IPv6 CIDR blocks, which ValidateIPv4CIDRBlock accepts.
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid IPv4 CIDR block", cidr)
+	}
+
+	return prefix, nil
+}
+
+// cidrRange is an inclusive range of IPv4 addresses.
+type cidrRange struct {
+	first, last uint64
+}
+
+func newCIDRRange(prefix netip.Prefix) cidrRange {
+	a := prefix.Masked().Addr().As4()
+	first := uint64(a[0])<<24 | uint64(a[1])<<16 | uint64(a[2])<<8 | uint64(a[3])
+
+	return cidrRange{
+		first: first,
+		last:  first + uint64(1)<<(32-prefix.Bits()) - 1,
+	}
+}
+
+func (r cidrRange) overlaps(o cidrRange) bool {
+	return r.first <= o.last && o.first <= r.last
+}
+
+func (r cidrRange) prefix(bits int) netip.Prefix {
+	return netip.PrefixFrom(netip.AddrFrom4([4]byte{byte(r.first >> 24), byte(r.first >> 16), byte(r.first >> 8), byte(r.first)}), bits)
+}
+
+// allocateCIDRRange returns the lowest block of the specified size, aligned to its size, within
+// the parent range that does not overlap any allocated range.
+func allocateCIDRRange(parent cidrRange, size uint64, allocated []cidrRange) (cidrRange, bool) {
+	for first := parent.first; first+size-1 <= parent.last; first += size {
+		candidate := cidrRange{first: first, last: first + size - 1}
+
+		free := true
+		for _, v := range allocated {
+			if candidate.overlaps(v) {
+				free = false
+				break
+			}
+		}
+
+		if free {
+			return candidate, true
+		}
+	}
+
+	return cidrRange{}, false
+}
+
+// ipv6SubnetPrefix returns the nth /64 of the specified /56.
+func ipv6SubnetPrefix(prefix netip.Prefix, n int) netip.Prefix {
+	a := prefix.Masked().Addr().As16()
+	a[7] = byte(n)
+
+	return netip.PrefixFrom(netip.AddrFrom16(a), cidrSubnetPlanIPv6SubnetPrefixLength)
+}
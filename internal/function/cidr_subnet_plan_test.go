@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestPlanCIDRSubnets(t *testing.T) {
+	t.Parallel()
+
+	type tier struct {
+		name         string
+		prefixLength int64
+	}
+	// Subnets are expressed as "tier/az=cidr[,ipv6]".
+	testCases := map[string]struct {
+		cidrBlock          string
+		availabilityZones  []string
+		tiers              []tier
+		reservedCIDRBlocks []string
+		ipv6CIDRBlock      string
+		expected           []string
+		expectError        bool
+	}{
+		"three tiers": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a", "b"},
+			tiers:             []tier{{"public", 24}, {"private", 20}, {"database", 26}},
+			expected: []string{
+				"public/a=10.0.0.0/24",
+				"public/b=10.0.1.0/24",
+				"private/a=10.0.16.0/20",
+				"private/b=10.0.32.0/20",
+				"database/a=10.0.2.0/26",
+				"database/b=10.0.2.64/26",
+			},
+		},
+		"reserved": {
+			cidrBlock:          "10.0.0.0/16",
+			availabilityZones:  []string{"a", "b"},
+			tiers:              []tier{{"public", 24}},
+			reservedCIDRBlocks: []string{"10.0.0.0/24", "10.0.2.128/25"},
+			expected: []string{
+				"public/a=10.0.1.0/24",
+				"public/b=10.0.3.0/24",
+			},
+		},
+		"reserved outside VPC": {
+			cidrBlock:          "10.0.0.0/24",
+			availabilityZones:  []string{"a"},
+			tiers:              []tier{{"public", 28}},
+			reservedCIDRBlocks: []string{"192.168.0.0/16"},
+			expected: []string{
+				"public/a=10.0.0.0/28",
+			},
+		},
+		"IPv6": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a", "b"},
+			tiers:             []tier{{"public", 24}, {"private", 24}},
+			ipv6CIDRBlock:     "2600:1f18:abc:de00::/56",
+			expected: []string{
+				"public/a=10.0.0.0/24,2600:1f18:abc:de00::/64",
+				"public/b=10.0.1.0/24,2600:1f18:abc:de01::/64",
+				"private/a=10.0.2.0/24,2600:1f18:abc:de02::/64",
+				"private/b=10.0.3.0/24,2600:1f18:abc:de03::/64",
+			},
+		},
+		"exhausted": {
+			cidrBlock:         "10.0.0.0/24",
+			availabilityZones: []string{"a", "b", "c"},
+			tiers:             []tier{{"public", 25}},
+			expectError:       true,
+		},
+		"reserved exhausts": {
+			cidrBlock:          "10.0.0.0/24",
+			availabilityZones:  []string{"a"},
+			tiers:              []tier{{"public", 28}},
+			reservedCIDRBlocks: []string{"10.0.0.0/24"},
+			expectError:        true,
+		},
+		"invalid VPC CIDR block": {
+			cidrBlock:         "10.0.0.1/16",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 24}},
+			expectError:       true,
+		},
+		"IPv6 VPC CIDR block": {
+			cidrBlock:         "2600:1f18:abc:de00::/56",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 24}},
+			expectError:       true,
+		},
+		"invalid reserved CIDR block": {
+			cidrBlock:          "10.0.0.0/16",
+			availabilityZones:  []string{"a"},
+			tiers:              []tier{{"public", 24}},
+			reservedCIDRBlocks: []string{"10.0.0.0"},
+			expectError:        true,
+		},
+		"prefix length shorter than VPC": {
+			cidrBlock:         "10.0.0.0/20",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 16}},
+			expectError:       true,
+		},
+		"prefix length too long": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 29}},
+			expectError:       true,
+		},
+		"duplicate tier": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 24}, {"public", 24}},
+			expectError:       true,
+		},
+		"duplicate Availability Zone": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a", "a"},
+			tiers:             []tier{{"public", 24}},
+			expectError:       true,
+		},
+		"no Availability Zones": {
+			cidrBlock:   "10.0.0.0/16",
+			tiers:       []tier{{"public", 24}},
+			expectError: true,
+		},
+		"IPv6 not /56": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a"},
+			tiers:             []tier{{"public", 24}},
+			ipv6CIDRBlock:     "2600:1f18:abc::/48",
+			expectError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var tiers []tffunction.CIDRSubnetPlanTier
+			for _, v := range testCase.tiers {
+				tiers = append(tiers, tffunction.CIDRSubnetPlanTier{Name: v.name, PrefixLength: v.prefixLength})
+			}
+
+			subnets, err := tffunction.PlanCIDRSubnets(testCase.cidrBlock, testCase.availabilityZones, tiers, testCase.reservedCIDRBlocks, testCase.ipv6CIDRBlock)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("PlanCIDRSubnets() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var got []string
+			for _, v := range subnets {
+				s := v.Tier + "/" + v.AvailabilityZone + "=" + v.CIDRBlock
+				if v.IPv6CIDRBlock != "" {
+					s += "," + v.IPv6CIDRBlock
+				}
+				got = append(got, s)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected subnets diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCIDRSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetPlanFunctionConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_az1", "10.0.0.0/24"),
+					resource.TestCheckOutput("public_az2", "10.0.2.0/24"),
+					resource.TestCheckOutput("private_az1", "10.0.16.0/20"),
+					resource.TestCheckOutput("database_az2", "10.0.3.64/26"),
+					resource.TestCheckOutput("private_az2_ipv6", "2600:1f18:abc:de03::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetPlanFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetPlanFunctionConfig_exhausted,
+				ExpectError: regexache.MustCompile(`no[\s\n]*free[\s\n]*/25`),
+			},
+		},
+	})
+}
+
+const testCIDRSubnetPlanFunctionConfig_basic = `
+locals {
+  result = provider::aws::cidr_subnet_plan(
+    "10.0.0.0/16",
+    ["use1-az1", "use1-az2"],
+    [
+      { name = "public", prefix_length = 24 },
+      { name = "private", prefix_length = 20 },
+      { name = "database", prefix_length = 26 },
+    ],
+    ["10.0.1.0/24"],
+    "2600:1f18:abc:de00::/56",
+  )
+}
+
+output "public_az1" {
+  value = local.result["public"]["use1-az1"].cidr_block
+}
+
+output "public_az2" {
+  value = local.result["public"]["use1-az2"].cidr_block
+}
+
+output "private_az1" {
+  value = local.result["private"]["use1-az1"].cidr_block
+}
+
+output "database_az2" {
+  value = local.result["database"]["use1-az2"].cidr_block
+}
+
+output "private_az2_ipv6" {
+  value = local.result["private"]["use1-az2"].ipv6_cidr_block
+}
+`
+
+const testCIDRSubnetPlanFunctionConfig_exhausted = `
+locals {
+  result = provider::aws::cidr_subnet_plan(
+    "10.0.0.0/24",
+    ["use1-az1", "use1-az2", "use1-az3"],
+    [{ name = "public", prefix_length = 25 }],
+    null,
+    null,
+  )
+}
+
+output "result" {
+  value = local.result
+}
+`
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = dnsSuffixFunction{}
+
+func NewDNSSuffixFunction() function.Function {
+	return &dnsSuffixFunction{}
+}
+
+type dnsSuffixFunction struct{}
+
+func (f dnsSuffixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_suffix"
+}
+
+func (f dnsSuffixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dns_suffix Function",
+		MarkdownDescription: "Returns the DNS suffix of the partition that contains an AWS Region, for example `amazonaws.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region, for example `us-west-2`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dnsSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	c, err := newAWSClientForRegion(region, nil)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, c.DNSSuffix(ctx)))
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDNSSuffixFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDNSSuffixFunctionConfig("us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com"),
+				),
+			},
+			{
+				Config: testDNSSuffixFunctionConfig("cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testDNSSuffixFunctionConfig("us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSSuffixFunction_invalidRegion(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDNSSuffixFunctionConfig("not-a-region"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*AWS[\s\n]*Region`),
+			},
+		},
+	})
+}
+
+func testDNSSuffixFunctionConfig(region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::dns_suffix(%[1]q)
+}
+`, region)
+}
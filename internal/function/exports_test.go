@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// Exports for use in tests only.
+var (
+	EvaluateIAMPolicies = evaluateIAMPolicies
+	PlanCIDRSubnets     = planCIDRSubnets
+)
+
+type CIDRSubnetPlanTier = cidrSubnetPlanTier
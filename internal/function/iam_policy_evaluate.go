@@ -0,0 +1,621 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IAM policy evaluation logic reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html
+
+const (
+	iamPolicyDecisionAllow        = "Allow"
+	iamPolicyDecisionExplicitDeny = "ExplicitDeny"
+	iamPolicyDecisionImplicitDeny = "ImplicitDeny"
+)
+
+var iamPolicyEvaluateResultAttrTypes = map[string]attr.Type{
+	"allowed":            types.BoolType,
+	"decision":           types.StringType,
+	"matched_statements": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates one or more IAM policy documents against an action, resource and " +
+			"condition context without calling AWS. Returns the decision and the statements which determined it.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy documents in JSON format",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, for example `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "Amazon Resource Name (ARN) of the resource to evaluate",
+			},
+			function.MapParameter{
+				Name:                "context",
+				MarkdownDescription: "Condition context keys and their values, for example `aws:SourceIp`",
+				ElementType:         types.ListType{ElemType: types.StringType},
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var action, resource string
+	var requestContext map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &action, &resource, &requestContext))
+	if resp.Error != nil {
+		return
+	}
+
+	decision, matched, err := evaluateIAMPolicies(policies, action, resource, requestContext)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	statements, d := types.ListValueFrom(ctx, types.StringType, matched)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"allowed":            types.BoolValue(decision == iamPolicyDecisionAllow),
+		"decision":           types.StringValue(decision),
+		"matched_statements": statements,
+	}
+
+	result, d := types.ObjectValue(iamPolicyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// iamPolicyStringOrSlice unmarshals IAM policy elements which may be either
+// a single string or an array of strings
+type iamPolicyStringOrSlice []string
+
+func (s *iamPolicyStringOrSlice) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	switch v := v.(type) {
+	case string:
+		*s = []string{v}
+	case []any:
+		for _, e := range v {
+			switch e := e.(type) {
+			case string:
+				*s = append(*s, e)
+			case bool:
+				*s = append(*s, strconv.FormatBool(e))
+			case float64:
+				*s = append(*s, strconv.FormatFloat(e, 'f', -1, 64))
+			default:
+				return fmt.Errorf("unsupported value type %T", e)
+			}
+		}
+	case bool:
+		*s = []string{strconv.FormatBool(v)}
+	case float64:
+		*s = []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	default:
+		return fmt.Errorf("unsupported value type %T", v)
+	}
+
+	return nil
+}
+
+type iamPolicyStatements []iamPolicyStatement
+
+func (s *iamPolicyStatements) UnmarshalJSON(b []byte) error {
+	var statements []iamPolicyStatement
+	if err := json.Unmarshal(b, &statements); err == nil {
+		*s = statements
+		return nil
+	}
+
+	var statement iamPolicyStatement
+	if err := json.Unmarshal(b, &statement); err != nil {
+		return err
+	}
+	*s = iamPolicyStatements{statement}
+
+	return nil
+}
+
+type iamPolicyDocument struct {
+	Version   string              `json:"Version"`
+	Statement iamPolicyStatements `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid         string                                       `json:"Sid"`
+	Effect      string                                       `json:"Effect"`
+	Action      iamPolicyStringOrSlice                       `json:"Action"`
+	NotAction   iamPolicyStringOrSlice                       `json:"NotAction"`
+	Resource    iamPolicyStringOrSlice                       `json:"Resource"`
+	NotResource iamPolicyStringOrSlice                       `json:"NotResource"`
+	Condition   map[string]map[string]iamPolicyStringOrSlice `json:"Condition"`
+}
+
+// evaluateIAMPolicies evaluates the policy documents for a single request and
+// returns the decision along with the identifiers of the statements that led
+// to it. Statements without a Sid are identified by their position.
+func evaluateIAMPolicies(policies []string, action, resource string, requestContext map[string][]string) (string, []string, error) {
+	if action == "" {
+		return "", nil, fmt.Errorf("action must be set")
+	}
+
+	// Context keys are case-insensitive.
+	keys := make(map[string][]string, len(requestContext))
+	for k, v := range requestContext {
+		keys[strings.ToLower(k)] = v
+	}
+
+	var allows, denies []string
+
+	for i, policy := range policies {
+		var doc iamPolicyDocument
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return "", nil, fmt.Errorf("parsing policy %d: %w", i, err)
+		}
+
+		for j, statement := range doc.Statement {
+			id := statement.Sid
+			if id == "" {
+				id = fmt.Sprintf("policies[%d].Statement[%d]", i, j)
+			}
+
+			ok, err := statement.matches(action, resource, keys)
+			if err != nil {
+				return "", nil, fmt.Errorf("evaluating %s: %w", id, err)
+			}
+			if !ok {
+				continue
+			}
+
+			switch statement.Effect {
+			case "Allow":
+				allows = append(allows, id)
+			case "Deny":
+				denies = append(denies, id)
+			default:
+				return "", nil, fmt.Errorf("evaluating %s: invalid Effect %q", id, statement.Effect)
+			}
+		}
+	}
+
+	switch {
+	case len(denies) > 0:
+		return iamPolicyDecisionExplicitDeny, denies, nil
+	case len(allows) > 0:
+		return iamPolicyDecisionAllow, allows, nil
+	default:
+		return iamPolicyDecisionImplicitDeny, []string{}, nil
+	}
+}
+
+func (s iamPolicyStatement) matches(action, resource string, keys map[string][]string) (bool, error) {
+	switch {
+	case len(s.Action) > 0 && len(s.NotAction) > 0:
+		return false, fmt.Errorf("only one of Action or NotAction may be set")
+	case len(s.Action) > 0:
+		if !slices.ContainsFunc(s.Action, func(pattern string) bool { return iamPolicyActionMatches(pattern, action) }) {
+			return false, nil
+		}
+	case len(s.NotAction) > 0:
+		if slices.ContainsFunc(s.NotAction, func(pattern string) bool { return iamPolicyActionMatches(pattern, action) }) {
+			return false, nil
+		}
+	default:
+		return false, fmt.Errorf("one of Action or NotAction must be set")
+	}
+
+	switch {
+	case len(s.Resource) > 0 && len(s.NotResource) > 0:
+		return false, fmt.Errorf("only one of Resource or NotResource may be set")
+	case len(s.Resource) > 0:
+		if !slices.ContainsFunc(s.Resource, func(pattern string) bool { return iamPolicyResourceMatches(pattern, resource, keys) }) {
+			return false, nil
+		}
+	case len(s.NotResource) > 0:
+		if slices.ContainsFunc(s.NotResource, func(pattern string) bool { return iamPolicyResourceMatches(pattern, resource, keys) }) {
+			return false, nil
+		}
+	}
+
+	// All condition operators, and all keys within an operator, must match.
+	for operator, conditions := range s.Condition {
+		for key, values := range conditions {
+			ok, err := evaluateIAMPolicyCondition(operator, key, values, keys)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// iamPolicyActionMatches matches actions case-insensitively.
+func iamPolicyActionMatches(pattern, action string) bool {
+	return wildcardMatch(strings.ToLower(pattern), strings.ToLower(action))
+}
+
+func iamPolicyResourceMatches(pattern, resource string, keys map[string][]string) bool {
+	pattern = substituteIAMPolicyVariables(pattern, keys)
+
+	if pattern == "*" {
+		return true
+	}
+
+	return arnLikeMatch(pattern, resource)
+}
+
+// substituteIAMPolicyVariables replaces ${key} policy variables with their
+// single value from the request context. Variables that have no value are
+// left in place, and so will not match.
+func substituteIAMPolicyVariables(s string, keys map[string][]string) string {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(s[start:], "}")
+		if end < 0 {
+			break
+		}
+		end += start
+
+		sb.WriteString(s[:start])
+		name := s[start+2 : end]
+
+		switch name {
+		case "*", "?", "$":
+			sb.WriteString(name)
+		default:
+			if v := keys[strings.ToLower(name)]; len(v) == 1 {
+				sb.WriteString(v[0])
+			} else {
+				sb.WriteString(s[start : end+1])
+			}
+		}
+
+		s = s[end+1:]
+	}
+	sb.WriteString(s)
+
+	return sb.String()
+}
+
+// arnLikeMatch matches each of the six colon-delimited ARN sections
+// separately, so that wildcards do not span sections.
+func arnLikeMatch(pattern, value string) bool {
+	if !arn.IsARN(pattern) || !arn.IsARN(value) {
+		return wildcardMatch(pattern, value)
+	}
+
+	patternSections := strings.SplitN(pattern, ":", 6)
+	valueSections := strings.SplitN(value, ":", 6)
+	if len(patternSections) != len(valueSections) {
+		return false
+	}
+
+	for i := range patternSections {
+		if !wildcardMatch(patternSections[i], valueSections[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wildcardMatch reports whether s matches pattern, where '*' matches any
+// sequence of characters and '?' matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	p, v := []rune(pattern), []rune(s)
+	pi, vi := 0, 0
+	star, mark := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			mark++
+			vi = mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+// evaluateIAMPolicyCondition evaluates a single condition key against the
+// request context. Multiple policy values for a key are ORed together, except
+// for negated operators where the request value must match none of them.
+func evaluateIAMPolicyCondition(operator, key string, policyValues []string, keys map[string][]string) (bool, error) {
+	op := operator
+
+	var forAllValues, forAnyValue bool
+	switch {
+	case strings.HasPrefix(op, "ForAllValues:"):
+		forAllValues = true
+		op = strings.TrimPrefix(op, "ForAllValues:")
+	case strings.HasPrefix(op, "ForAnyValue:"):
+		forAnyValue = true
+		op = strings.TrimPrefix(op, "ForAnyValue:")
+	}
+
+	ifExists := strings.HasSuffix(op, "IfExists")
+	op = strings.TrimSuffix(op, "IfExists")
+
+	requestValues, present := keys[strings.ToLower(key)]
+
+	if op == "Null" {
+		if len(policyValues) != 1 {
+			return false, fmt.Errorf("condition operator %s requires a single value", operator)
+		}
+		isNull, err := strconv.ParseBool(policyValues[0])
+		if err != nil {
+			return false, fmt.Errorf("condition operator %s: %w", operator, err)
+		}
+		return isNull == !present, nil
+	}
+
+	match, negated, err := iamPolicyConditionMatcher(op)
+	if err != nil {
+		return false, fmt.Errorf("condition operator %s: %w", operator, err)
+	}
+
+	matchesValue := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			policyValue = substituteIAMPolicyVariables(policyValue, keys)
+			ok, err := match(policyValue, requestValue)
+			if err != nil {
+				return false, fmt.Errorf("condition operator %s: %w", operator, err)
+			}
+			if ok {
+				return !negated, nil
+			}
+		}
+		return negated, nil
+	}
+
+	switch {
+	case forAllValues:
+		// True if every request value matches, including when there are none.
+		for _, v := range requestValues {
+			ok, err := matchesValue(v)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case forAnyValue:
+		for _, v := range requestValues {
+			ok, err := matchesValue(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case !present:
+		return ifExists || negated, nil
+	default:
+		for _, v := range requestValues {
+			ok, err := matchesValue(v)
+			if err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+type iamPolicyConditionMatchFunc func(policyValue, requestValue string) (bool, error)
+
+func iamPolicyConditionMatcher(op string) (iamPolicyConditionMatchFunc, bool, error) {
+	switch op {
+	case "StringEquals":
+		return iamPolicyStringEquals, false, nil
+	case "StringNotEquals":
+		return iamPolicyStringEquals, true, nil
+	case "StringEqualsIgnoreCase":
+		return iamPolicyStringEqualsIgnoreCase, false, nil
+	case "StringNotEqualsIgnoreCase":
+		return iamPolicyStringEqualsIgnoreCase, true, nil
+	case "StringLike":
+		return iamPolicyStringLike, false, nil
+	case "StringNotLike":
+		return iamPolicyStringLike, true, nil
+	case "NumericEquals":
+		return iamPolicyNumericCompare(func(c int) bool { return c == 0 }), false, nil
+	case "NumericNotEquals":
+		return iamPolicyNumericCompare(func(c int) bool { return c == 0 }), true, nil
+	case "NumericLessThan":
+		return iamPolicyNumericCompare(func(c int) bool { return c < 0 }), false, nil
+	case "NumericLessThanEquals":
+		return iamPolicyNumericCompare(func(c int) bool { return c <= 0 }), false, nil
+	case "NumericGreaterThan":
+		return iamPolicyNumericCompare(func(c int) bool { return c > 0 }), false, nil
+	case "NumericGreaterThanEquals":
+		return iamPolicyNumericCompare(func(c int) bool { return c >= 0 }), false, nil
+	case "DateEquals":
+		return iamPolicyDateCompare(func(c int) bool { return c == 0 }), false, nil
+	case "DateNotEquals":
+		return iamPolicyDateCompare(func(c int) bool { return c == 0 }), true, nil
+	case "DateLessThan":
+		return iamPolicyDateCompare(func(c int) bool { return c < 0 }), false, nil
+	case "DateLessThanEquals":
+		return iamPolicyDateCompare(func(c int) bool { return c <= 0 }), false, nil
+	case "DateGreaterThan":
+		return iamPolicyDateCompare(func(c int) bool { return c > 0 }), false, nil
+	case "DateGreaterThanEquals":
+		return iamPolicyDateCompare(func(c int) bool { return c >= 0 }), false, nil
+	case "Bool":
+		return iamPolicyStringEqualsIgnoreCase, false, nil
+	case "BinaryEquals":
+		return iamPolicyStringEquals, false, nil
+	case "IpAddress":
+		return iamPolicyIpAddress, false, nil
+	case "NotIpAddress":
+		return iamPolicyIpAddress, true, nil
+	case "ArnEquals", "ArnLike":
+		return iamPolicyArnLike, false, nil
+	case "ArnNotEquals", "ArnNotLike":
+		return iamPolicyArnLike, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported condition operator")
+	}
+}
+
+func iamPolicyStringEquals(policyValue, requestValue string) (bool, error) {
+	return policyValue == requestValue, nil
+}
+
+func iamPolicyStringEqualsIgnoreCase(policyValue, requestValue string) (bool, error) {
+	return strings.EqualFold(policyValue, requestValue), nil
+}
+
+func iamPolicyStringLike(policyValue, requestValue string) (bool, error) {
+	return wildcardMatch(policyValue, requestValue), nil
+}
+
+func iamPolicyArnLike(policyValue, requestValue string) (bool, error) {
+	return arnLikeMatch(policyValue, requestValue), nil
+}
+
+// iamPolicyNumericCompare returns a matcher which compares the request value to the
+// policy value, i.e. the comparison is "requestValue <op> policyValue".
+func iamPolicyNumericCompare(ok func(int) bool) iamPolicyConditionMatchFunc {
+	return func(policyValue, requestValue string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, err
+		}
+		r, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case r < p:
+			return ok(-1), nil
+		case r > p:
+			return ok(1), nil
+		default:
+			return ok(0), nil
+		}
+	}
+}
+
+func iamPolicyDateCompare(ok func(int) bool) iamPolicyConditionMatchFunc {
+	return func(policyValue, requestValue string) (bool, error) {
+		p, err := parseIAMPolicyDate(policyValue)
+		if err != nil {
+			return false, err
+		}
+		r, err := parseIAMPolicyDate(requestValue)
+		if err != nil {
+			return false, nil
+		}
+
+		return ok(r.Compare(p)), nil
+	}
+}
+
+// parseIAMPolicyDate parses dates in ISO 8601 format or as epoch seconds.
+func parseIAMPolicyDate(s string) (time.Time, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if v, err := time.Parse(layout, s); err == nil {
+			return v, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+func iamPolicyIpAddress(policyValue, requestValue string) (bool, error) {
+	prefix, err := netip.ParsePrefix(policyValue)
+	if err != nil {
+		addr, err := netip.ParseAddr(policyValue)
+		if err != nil {
+			return false, err
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	addr, err := netip.ParseAddr(requestValue)
+	if err != nil {
+		return false, nil
+	}
+
+	return prefix.Contains(addr), nil
+}
@@ -0,0 +1,376 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestEvaluateIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		allowS3 = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowS3",
+    "Effect": "Allow",
+    "Action": ["s3:Get*", "s3:List*"],
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`
+		denyNotTLS = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "DenyInsecureTransport",
+    "Effect": "Deny",
+    "Action": "s3:*",
+    "Resource": "*",
+    "Condition": {
+      "Bool": {
+        "aws:SecureTransport": "false"
+      }
+    }
+  }
+}`
+		allowNotIAM = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "NotAction": "iam:*",
+    "NotResource": "arn:aws:s3:::secret/*"
+  }]
+}`
+		allowFromNetwork = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowFromNetwork",
+    "Effect": "Allow",
+    "Action": "ec2:*",
+    "Resource": "*",
+    "Condition": {
+      "IpAddress": {
+        "aws:SourceIp": ["10.0.0.0/8", "192.0.2.1"]
+      },
+      "StringEqualsIfExists": {
+        "aws:RequestedRegion": "us-west-2"
+      }
+    }
+  }]
+}`
+		allowTagKeys = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowTagKeys",
+    "Effect": "Allow",
+    "Action": "ec2:CreateTags",
+    "Resource": "*",
+    "Condition": {
+      "ForAllValues:StringEquals": {
+        "aws:TagKeys": ["Name", "Environment"]
+      }
+    }
+  }]
+}`
+		denyUntagged = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyUntagged",
+    "Effect": "Deny",
+    "Action": "ec2:RunInstances",
+    "Resource": "*",
+    "Condition": {
+      "Null": {
+        "aws:RequestTag/Owner": "true"
+      }
+    }
+  }]
+}`
+		allowHomeFolder = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowHomeFolder",
+    "Effect": "Allow",
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::home/${aws:username}/*"
+  }]
+}`
+	)
+
+	testCases := map[string]struct {
+		policies         []string
+		action           string
+		resource         string
+		context          map[string][]string
+		expectedDecision string
+		expectedMatched  []string
+		expectError      bool
+	}{
+		"allow wildcard action": {
+			policies:         []string{allowS3},
+			action:           "S3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowS3"},
+		},
+		"implicit deny action": {
+			policies:         []string{allowS3},
+			action:           "s3:PutObject",
+			resource:         "arn:aws:s3:::example/key",
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"implicit deny resource": {
+			policies:         []string{allowS3},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::other/key",
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"explicit deny overrides allow": {
+			policies:         []string{allowS3, denyNotTLS},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			context:          map[string][]string{"aws:securetransport": {"false"}},
+			expectedDecision: "ExplicitDeny",
+			expectedMatched:  []string{"DenyInsecureTransport"},
+		},
+		"deny condition not met": {
+			policies:         []string{allowS3, denyNotTLS},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			context:          map[string][]string{"aws:SecureTransport": {"true"}},
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowS3"},
+		},
+		"NotAction and NotResource": {
+			policies:         []string{allowNotIAM},
+			action:           "sqs:SendMessage",
+			resource:         "arn:aws:sqs:us-west-2:123456789012:queue",
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"policies[0].Statement[0]"},
+		},
+		"NotAction excluded": {
+			policies:         []string{allowNotIAM},
+			action:           "iam:CreateRole",
+			resource:         "arn:aws:iam::123456789012:role/example",
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"NotResource excluded": {
+			policies:         []string{allowNotIAM},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::secret/key",
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"IpAddress match": {
+			policies:         []string{allowFromNetwork},
+			action:           "ec2:DescribeInstances",
+			resource:         "*",
+			context:          map[string][]string{"aws:SourceIp": {"10.1.2.3"}},
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowFromNetwork"},
+		},
+		"IpAddress single address": {
+			policies:         []string{allowFromNetwork},
+			action:           "ec2:DescribeInstances",
+			resource:         "*",
+			context:          map[string][]string{"aws:SourceIp": {"192.0.2.1"}, "aws:RequestedRegion": {"us-west-2"}},
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowFromNetwork"},
+		},
+		"IfExists present mismatch": {
+			policies:         []string{allowFromNetwork},
+			action:           "ec2:DescribeInstances",
+			resource:         "*",
+			context:          map[string][]string{"aws:SourceIp": {"10.1.2.3"}, "aws:RequestedRegion": {"us-east-1"}},
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"IpAddress missing key": {
+			policies:         []string{allowFromNetwork},
+			action:           "ec2:DescribeInstances",
+			resource:         "*",
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"ForAllValues subset": {
+			policies:         []string{allowTagKeys},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			context:          map[string][]string{"aws:TagKeys": {"Name"}},
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowTagKeys"},
+		},
+		"ForAllValues not subset": {
+			policies:         []string{allowTagKeys},
+			action:           "ec2:CreateTags",
+			resource:         "*",
+			context:          map[string][]string{"aws:TagKeys": {"Name", "Owner"}},
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"Null missing key": {
+			policies:         []string{denyUntagged},
+			action:           "ec2:RunInstances",
+			resource:         "*",
+			expectedDecision: "ExplicitDeny",
+			expectedMatched:  []string{"DenyUntagged"},
+		},
+		"Null present key": {
+			policies:         []string{denyUntagged},
+			action:           "ec2:RunInstances",
+			resource:         "*",
+			context:          map[string][]string{"aws:RequestTag/Owner": {"team"}},
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"policy variable": {
+			policies:         []string{allowHomeFolder},
+			action:           "s3:PutObject",
+			resource:         "arn:aws:s3:::home/alice/file",
+			context:          map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: "Allow",
+			expectedMatched:  []string{"AllowHomeFolder"},
+		},
+		"policy variable mismatch": {
+			policies:         []string{allowHomeFolder},
+			action:           "s3:PutObject",
+			resource:         "arn:aws:s3:::home/bob/file",
+			context:          map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: "ImplicitDeny",
+			expectedMatched:  []string{},
+		},
+		"invalid JSON": {
+			policies:    []string{"{"},
+			action:      "s3:GetObject",
+			resource:    "*",
+			expectError: true,
+		},
+		"unsupported operator": {
+			policies:    []string{`{"Statement":{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSortOf":{"k":"v"}}}}`},
+			action:      "s3:GetObject",
+			resource:    "*",
+			context:     map[string][]string{"k": {"v"}},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			decision, matched, err := tffunction.EvaluateIAMPolicies(testCase.policies, testCase.action, testCase.resource, testCase.context)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("EvaluateIAMPolicies() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := decision, testCase.expectedDecision; got != want {
+				t.Errorf("decision = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(matched, testCase.expectedMatched); diff != "" {
+				t.Errorf("unexpected matched statements diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyEvaluateFunction_allow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "Allow"),
+					resource.TestCheckOutput("allowed", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:PutObject", "arn:aws:s3:::example/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "ImplicitDeny"),
+					resource.TestCheckOutput("allowed", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEvaluateFunctionConfig("", "arn:aws:s3:::example/key"),
+				ExpectError: regexache.MustCompile(`action[\s\n]*must[\s\n]*be[\s\n]*set`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig(action, resource string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::iam_policy_evaluate(
+    [jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "arn:aws:s3:::example/*"
+      }]
+    })],
+    %[1]q,
+    %[2]q,
+    null,
+  )
+}
+
+output "decision" {
+  value = local.result.decision
+}
+
+output "allowed" {
+  value = local.result.allowed
+}
+`, action, resource)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = partitionHostnameFunction{}
+
+func NewPartitionHostnameFunction() function.Function {
+	return &partitionHostnameFunction{}
+}
+
+type partitionHostnameFunction struct{}
+
+func (f partitionHostnameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "partition_hostname"
+}
+
+func (f partitionHostnameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "partition_hostname Function",
+		MarkdownDescription: "Returns a hostname with the DNS suffix of the partition that contains an AWS Region, for example `PREFIX.amazonaws.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "Hostname prefix, without a trailing period",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region, for example `us-west-2`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f partitionHostnameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if prefix == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("prefix must be set"))
+		return
+	}
+
+	c, err := newAWSClientForRegion(region, nil)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, c.PartitionHostname(ctx, prefix)))
+}
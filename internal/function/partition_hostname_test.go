@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPartitionHostnameFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPartitionHostnameFunctionConfig("example", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example.amazonaws.com"),
+				),
+			},
+			{
+				Config: testPartitionHostnameFunctionConfig("example", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "example.amazonaws.com.cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestPartitionHostnameFunction_emptyPrefix(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testPartitionHostnameFunctionConfig("", "us-west-2"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*must[\s\n]*be[\s\n]*set`),
+			},
+		},
+	})
+}
+
+func testPartitionHostnameFunctionConfig(prefix, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::partition_hostname(%[1]q, %[2]q)
+}
+`, prefix, region)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	serviceEndpointOptionUseDualStack = "use_dualstack"
+	serviceEndpointOptionUseFIPS      = "use_fips"
+)
+
+var _ function.Function = serviceEndpointFunction{}
+
+func NewServiceEndpointFunction(servicePackages iter.Seq[conns.ServicePackage]) function.Function {
+	return &serviceEndpointFunction{
+		servicePackages: servicePackages,
+	}
+}
+
+type serviceEndpointFunction struct {
+	servicePackages iter.Seq[conns.ServicePackage]
+}
+
+func (f serviceEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_endpoint"
+}
+
+func (f serviceEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "service_endpoint Function",
+		MarkdownDescription: "Returns the endpoint URL that the provider uses for an AWS service in an AWS Region, " +
+			"for example `https://sqs.us-west-2.amazonaws.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service, as used in the provider's `endpoints` configuration block, for example `sqs`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region, for example `us-west-2`",
+			},
+			function.MapParameter{
+				Name:                "options",
+				MarkdownDescription: "Endpoint options. Valid keys are `use_fips` and `use_dualstack`",
+				ElementType:         types.BoolType,
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f serviceEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+	var options map[string]bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	for k := range options {
+		switch k {
+		case serviceEndpointOptionUseDualStack, serviceEndpointOptionUseFIPS:
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unsupported option %q", k)))
+			return
+		}
+	}
+
+	// Accept any of the names that the provider's endpoints configuration block accepts.
+	if v, err := names.ProviderPackageForAlias(service); err == nil {
+		service = v
+	}
+
+	c, err := newAWSClientForRegion(region, f.servicePackages)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := c.ResolveEndpoint(ctx, service, options[serviceEndpointOptionUseFIPS], options[serviceEndpointOptionUseDualStack])
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
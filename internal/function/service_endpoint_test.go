@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestServiceEndpointFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("sqs", "us-west-2", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://sqs.us-west-2.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServiceEndpointFunctionConfig("sqs", "cn-north-1", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://sqs.cn-north-1.amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testServiceEndpointFunctionConfig("iam", "us-gov-west-1", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://iam.us-gov.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_dualStack(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServiceEndpointFunctionConfig("s3", "eu-west-1", "{ use_dualstack = true }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://s3.dualstack.eu-west-1.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_unknownService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("notaservice", "us-west-2", "null"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*service[\s\n]*package`),
+			},
+		},
+	})
+}
+
+func TestServiceEndpointFunction_unsupportedOption(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServiceEndpointFunctionConfig("sqs", "us-west-2", "{ use_ipv6 = true }"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*option`),
+			},
+		},
+	})
+}
+
+func testServiceEndpointFunctionConfig(service, region, options string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_endpoint(%[1]q, %[2]q, %[3]s)
+}
+`, service, region, options)
+}
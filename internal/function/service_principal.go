@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = servicePrincipalFunction{}
+
+func NewServicePrincipalFunction() function.Function {
+	return &servicePrincipalFunction{}
+}
+
+type servicePrincipalFunction struct{}
+
+func (f servicePrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_principal"
+}
+
+func (f servicePrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "service_principal Function",
+		MarkdownDescription: "Returns the service principal name of an AWS service in the partition that contains an AWS Region, " +
+			"for example `logs.amazonaws.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service identifier, for example `logs`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region, for example `us-west-2`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f servicePrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("service must be set"))
+		return
+	}
+
+	c, err := newAWSClientForRegion(region, nil)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, c.ServicePrincipalName(ctx, service)))
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestServicePrincipalFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testServicePrincipalFunctionConfig("lambda", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "lambda.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestServicePrincipalFunction_emptyService(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServicePrincipalFunctionConfig("", "us-west-2"),
+				ExpectError: regexache.MustCompile(`service[\s\n]*must[\s\n]*be[\s\n]*set`),
+			},
+		},
+	})
+}
+
+func testServicePrincipalFunctionConfig(service, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_principal(%[1]q, %[2]q)
+}
+`, service, region)
+}
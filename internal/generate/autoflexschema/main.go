@@ -0,0 +1,1737 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	basetypesPackage = "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	frameworkPackage = "github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypesPackage   = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	sdkPackagePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	// requiredMarker is how the AWS SDK for Go v2 documents required structure members.
+	requiredMarker = "This member is required."
+)
+
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+	plural     = pluralize.NewClient()
+
+	// kinds are the schema value kinds, in the order model types are tested for them.
+	kinds = []string{"String", "Bool", "Int64", "Int32", "Float64", "Float32", "Number", "List", "Set", "Map", "Object", "Dynamic"}
+
+	// templateImports are the packages imported by the generated file's template, by path.
+	templateImports = map[string]string{
+		"context": "context",
+		"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator": "listvalidator",
+		"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator":  "setvalidator",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema":          "schema",
+		"github.com/hashicorp/terraform-plugin-framework/schema/validator":         "validator",
+		"github.com/hashicorp/terraform-plugin-framework/types":                    "types",
+		frameworkPackage: "framework",
+		fwtypesPackage:   "fwtypes",
+		"github.com/hashicorp/terraform-provider-aws/internal/tags": "tftags",
+	}
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-schema-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+	Imports        []string
+	Functions      []*function
+}
+
+//go:embed file.gtpl
+var fileTemplate string
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	filename := "autoflex_schema_gen.go"
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Checking AutoFlex schemas in internal/service/%s", servicePackage)
+
+	generating, err := generatesSchemas()
+	if err != nil {
+		g.Fatalf("scanning package: %s", err)
+	}
+
+	pkg, err := loadPackage(servicePackage, filename, generating)
+	if err != nil {
+		g.Fatalf("loading package: %s", err)
+	}
+
+	c := newChecker(pkg)
+	models := c.annotatedModels()
+	if len(c.errs) > 0 {
+		for _, err := range c.errs {
+			g.Errorf("%s", err)
+		}
+		g.Fatalf("parsing @AutoFlexSchema annotations")
+	}
+	if len(models) == 0 {
+		g.Fatalf("no @AutoFlexSchema annotations found")
+	}
+
+	if len(pkg.Errors) > 0 {
+		// Until the file is generated, code that calls the generated functions does not compile.
+		if !generating {
+			g.Fatalf("type checking: %s", pkg.Errors[0])
+		}
+		g.Warnf("type checking: %s", pkg.Errors[0])
+	}
+
+	schemas := c.schemas()
+	for _, m := range models {
+		if m.generate {
+			continue
+		}
+
+		g.Infof("  %s", m.model.Obj().Name())
+
+		object, ok := schemas[m.model]
+		if !ok {
+			c.errorf(m.model.Obj().Pos(), "%s: no Schema method found for a resource or data source with this model", m.model.Obj().Name())
+			continue
+		}
+
+		c.validate(mapping{
+			model:  m.model,
+			input:  m.input,
+			output: m.output,
+			object: object,
+			prefix: m.prefix,
+			suffix: m.suffix,
+		})
+	}
+
+	var functions []*function
+	if generating {
+		gen := newGenerator(c)
+		for _, m := range models {
+			if m.generate {
+				gen.function(mapping{
+					model:  m.model,
+					input:  m.input,
+					output: m.output,
+					prefix: m.prefix,
+					suffix: m.suffix,
+				})
+			}
+		}
+		functions = gen.functions
+	}
+
+	for _, warning := range c.warnings {
+		g.Warnf("%s", warning)
+	}
+	if len(c.errs) > 0 {
+		for _, err := range c.errs {
+			g.Errorf("%s", err)
+		}
+		g.Fatalf("%d AutoFlex schema mismatches found", len(c.errs))
+	}
+
+	if !generating {
+		return
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	templateData := TemplateData{
+		Parameters:     strings.Join(os.Args[1:], " "),
+		ServicePackage: servicePackage,
+		Imports:        c.generatedImports(),
+		Functions:      functions,
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("file", fileTemplate, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// generatesSchemas returns whether any model in the current directory is annotated with generate=true.
+// The source is scanned before the package is loaded, which is slow, to decide how to load it.
+func generatesSchemas() (bool, error) {
+	for file, err := range common.ScanDirectory(".") {
+		if err != nil {
+			return false, err
+		}
+
+		for _, group := range file.File().Comments {
+			for _, line := range group.List {
+				m := annotation.FindStringSubmatch(line.Text)
+				if len(m) == 0 || m[1] != "AutoFlexSchema" {
+					continue
+				}
+				if args, err := common.ParseArgs(m[3]); err == nil {
+					if v, err := strconv.ParseBool(args.Keyword["generate"]); err == nil && v {
+						return true, nil
+					}
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// loadPackage loads and type checks the service package in the current directory.
+// If schemas are being generated, any previously generated file is replaced by an empty one, as it may no longer compile.
+func loadPackage(servicePackage, filename string, generating bool) (*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+	}
+
+	if _, err := os.Stat(filename); err == nil && generating {
+		path, err := filepath.Abs(filename)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Overlay = map[string][]byte{
+			path: fmt.Appendf(nil, "package %s\n", servicePackage),
+		}
+	}
+
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found", len(pkgs))
+	}
+
+	return pkgs[0], nil
+}
+
+// annotatedModel is a model struct annotated with @AutoFlexSchema.
+type annotatedModel struct {
+	model          *types.Named
+	input          *types.Named
+	output         *types.Named
+	generate       bool
+	prefix, suffix string
+}
+
+// mapping pairs a model struct with the SDK structures AutoFlex expands it to and
+// flattens it from, and with its schema, if any.
+type mapping struct {
+	model          *types.Named
+	input          *types.Named
+	output         *types.Named
+	object         *object
+	prefix, suffix string
+}
+
+// object is the attributes and blocks of a schema or nested object, as written in source.
+type object struct {
+	attributes map[string]*element
+	blocks     map[string]*element
+	// opaque is set if the attributes or blocks are built in a way the generator cannot follow.
+	opaque bool
+}
+
+// element is a schema attribute or block, as written in source.
+type element struct {
+	pos token.Pos
+	// typeName is the name of the schema type, e.g. StringAttribute or ListNestedBlock.
+	typeName   string
+	customType types.Type
+	required   bool
+	optional   bool
+	computed   bool
+	// maxItems is the maximum size set by a SizeAtMost or SizeBetween validator, or -1.
+	maxItems int
+	nested   *object
+	// opaque is set if the element is returned by a function the generator cannot follow.
+	opaque bool
+}
+
+func (e *element) block() bool {
+	return strings.HasSuffix(e.typeName, "Block")
+}
+
+// kind returns the schema value kind of the element, e.g. String or List.
+func (e *element) kind() string {
+	name, ok := strings.CutSuffix(e.typeName, "Attribute")
+	if !ok {
+		if name, ok = strings.CutSuffix(e.typeName, "Block"); !ok {
+			return ""
+		}
+	}
+
+	name = strings.TrimSuffix(name, "Nested")
+	if name == "Single" {
+		return "Object"
+	}
+
+	return name
+}
+
+// modelField is a model struct field that AutoFlex maps.
+type modelField struct {
+	v      *types.Var
+	tfName string
+	// sdkName is the name of the SDK structure field, set by an autoflex tag.
+	sdkName   string
+	noExpand  bool
+	noFlatten bool
+	// xmlWrapper is the name of the SDK wrapper structure's slice field.
+	xmlWrapper string
+}
+
+type checker struct {
+	pkg       *packages.Package
+	funcDecls map[*types.Func]*ast.FuncDecl
+	// importNames holds the names that the package's files import packages as, by path.
+	importNames map[string]string
+	// imports holds the packages referenced by generated code, by path.
+	imports  map[string]string
+	sdkFiles map[string]*ast.File
+	required map[*types.Named]map[string]bool
+	visited  map[mapping]bool
+	errs     []string
+	warnings []string
+}
+
+func newChecker(pkg *packages.Package) *checker {
+	c := &checker{
+		pkg:         pkg,
+		funcDecls:   make(map[*types.Func]*ast.FuncDecl),
+		importNames: make(map[string]string),
+		imports:     make(map[string]string),
+		sdkFiles:    make(map[string]*ast.File),
+		required:    make(map[*types.Named]map[string]bool),
+		visited:     make(map[mapping]bool),
+	}
+
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				if f, ok := pkg.TypesInfo.Defs[funcDecl.Name].(*types.Func); ok {
+					c.funcDecls[f] = funcDecl
+				}
+			}
+		}
+
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+			if _, ok := c.importNames[path]; ok {
+				continue
+			}
+			if spec.Name != nil {
+				c.importNames[path] = spec.Name.Name
+			} else if p := c.importedPackage(path); p != nil {
+				c.importNames[path] = p.Name()
+			}
+		}
+	}
+
+	return c
+}
+
+func (c *checker) warnf(pos token.Pos, format string, a ...any) {
+	position := c.pkg.Fset.Position(pos)
+	c.warnings = append(c.warnings, fmt.Sprintf("%s:%d:%d: %s", filepath.Base(position.Filename), position.Line, position.Column, fmt.Sprintf(format, a...)))
+}
+
+func (c *checker) errorf(pos token.Pos, format string, a ...any) {
+	position := c.pkg.Fset.Position(pos)
+	err := fmt.Sprintf("%s:%d:%d: %s", filepath.Base(position.Filename), position.Line, position.Column, fmt.Sprintf(format, a...))
+	// A field's input and output SDK types are usually the same, so the same mismatch may be found twice.
+	if !slices.Contains(c.errs, err) {
+		c.errs = append(c.errs, err)
+	}
+}
+
+// annotatedModels returns the model structs annotated with @AutoFlexSchema.
+func (c *checker) annotatedModels() []*annotatedModel {
+	var models []*annotatedModel
+
+	for _, file := range c.pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				doc := typeSpec.Doc
+				if doc == nil && len(genDecl.Specs) == 1 {
+					doc = genDecl.Doc
+				}
+				if doc == nil {
+					continue
+				}
+
+				for _, line := range doc.List {
+					m := annotation.FindStringSubmatch(line.Text)
+					if len(m) == 0 || m[1] != "AutoFlexSchema" {
+						continue
+					}
+
+					if model := c.annotatedModel(file, typeSpec, m[3]); model != nil {
+						models = append(models, model)
+					}
+				}
+			}
+		}
+	}
+
+	return models
+}
+
+func (c *checker) annotatedModel(file *ast.File, typeSpec *ast.TypeSpec, s string) *annotatedModel {
+	name := typeSpec.Name.Name
+
+	args, err := common.ParseArgs(s)
+	if err != nil {
+		c.errorf(typeSpec.Pos(), "%s: parsing annotation arguments: %s", name, err)
+		return nil
+	}
+
+	typeName, ok := c.pkg.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	named, ok := typeName.Type().(*types.Named)
+	if !ok {
+		c.errorf(typeSpec.Pos(), "%s: @AutoFlexSchema applies only to struct types", name)
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		c.errorf(typeSpec.Pos(), "%s: @AutoFlexSchema applies only to struct types", name)
+		return nil
+	}
+
+	model := &annotatedModel{
+		model:  named,
+		prefix: args.Keyword["prefix"],
+		suffix: args.Keyword["suffix"],
+	}
+
+	if v, ok := args.Keyword["input"]; ok {
+		if model.input = c.sdkStruct(file, typeSpec, v); model.input == nil {
+			return nil
+		}
+	}
+	if v, ok := args.Keyword["output"]; ok {
+		if model.output = c.sdkStruct(file, typeSpec, v); model.output == nil {
+			return nil
+		}
+	}
+	if model.input == nil && model.output == nil {
+		c.errorf(typeSpec.Pos(), "%s: @AutoFlexSchema requires an input or output SDK type", name)
+		return nil
+	}
+
+	if v, ok := args.Keyword["generate"]; ok {
+		if model.generate, err = strconv.ParseBool(v); err != nil {
+			c.errorf(typeSpec.Pos(), "%s: invalid generate value %q", name, v)
+			return nil
+		}
+	}
+
+	return model
+}
+
+// sdkStruct resolves a qualified type name, e.g. awstypes.Processor, using the file's imports.
+func (c *checker) sdkStruct(file *ast.File, typeSpec *ast.TypeSpec, s string) *types.Named {
+	qualifier, name, ok := strings.Cut(s, ".")
+	if !ok {
+		c.errorf(typeSpec.Pos(), "%s: SDK type %q is not qualified by a package name", typeSpec.Name.Name, s)
+		return nil
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		p := c.importedPackage(path)
+		if p == nil {
+			continue
+		}
+		if (spec.Name != nil && spec.Name.Name != qualifier) || (spec.Name == nil && p.Name() != qualifier) {
+			continue
+		}
+
+		if typeName, ok := p.Scope().Lookup(name).(*types.TypeName); ok {
+			if named, ok := typeName.Type().(*types.Named); ok {
+				if _, ok := named.Underlying().(*types.Struct); ok {
+					return named
+				}
+			}
+		}
+		c.errorf(typeSpec.Pos(), "%s: SDK type %s is not a structure", typeSpec.Name.Name, s)
+		return nil
+	}
+
+	c.errorf(typeSpec.Pos(), "%s: package %s of SDK type %s is not imported in %s", typeSpec.Name.Name, qualifier, s, filepath.Base(c.pkg.Fset.Position(file.Pos()).Filename))
+	return nil
+}
+
+// importedPackage returns the package with the given path imported by the service package.
+func (c *checker) importedPackage(path string) *types.Package {
+	for _, p := range c.pkg.Types.Imports() {
+		if p.Path() == path {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// schemas returns the schema of each resource and data source in the package, by model.
+// Resources and data sources declare their model by embedding framework.ResourceWithModel or
+// framework.DataSourceWithModel and set the schema in their Schema method.
+func (c *checker) schemas() map[*types.Named]*object {
+	models := make(map[string]*types.Named)
+
+	scope := c.pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		s, ok := typeName.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		for field := range s.Fields() {
+			if !field.Embedded() {
+				continue
+			}
+			named, ok := types.Unalias(field.Type()).(*types.Named)
+			if !ok || named.TypeArgs().Len() == 0 || !inPackage(named, frameworkPackage) || !strings.HasSuffix(named.Obj().Name(), "WithModel") {
+				continue
+			}
+			if model, ok := named.TypeArgs().At(0).(*types.Named); ok {
+				models[name] = model
+			}
+		}
+	}
+
+	schemas := make(map[*types.Named]*object)
+
+	for _, file := range c.pkg.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || funcDecl.Name.Name != "Schema" || funcDecl.Body == nil {
+				continue
+			}
+
+			recv := funcDecl.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			ident, ok := recv.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			model, ok := models[ident.Name]
+			if !ok {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				assign, ok := n.(*ast.AssignStmt)
+				if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+					return true
+				}
+				if sel, ok := assign.Lhs[0].(*ast.SelectorExpr); ok && sel.Sel.Name == "Schema" {
+					schemas[model] = c.object(assign.Rhs[0])
+				}
+				return false
+			})
+		}
+	}
+
+	return schemas
+}
+
+// compositeLit returns the composite literal that expr evaluates to, following calls
+// to functions in the package that return a single composite literal.
+func (c *checker) compositeLit(expr ast.Expr) *ast.CompositeLit {
+	for range 5 {
+		switch e := ast.Unparen(expr).(type) {
+		case *ast.CompositeLit:
+			return e
+		case *ast.CallExpr:
+			ident, ok := ast.Unparen(e.Fun).(*ast.Ident)
+			if !ok {
+				return nil
+			}
+			f, ok := c.pkg.TypesInfo.Uses[ident].(*types.Func)
+			if !ok {
+				return nil
+			}
+			funcDecl, ok := c.funcDecls[f]
+			if !ok || funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+				return nil
+			}
+			ret, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				return nil
+			}
+			expr = ret.Results[0]
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// object parses a schema or nested object.
+func (c *checker) object(expr ast.Expr) *object {
+	lit := c.compositeLit(expr)
+	if lit == nil {
+		return &object{opaque: true}
+	}
+
+	o := &object{
+		attributes: make(map[string]*element),
+		blocks:     make(map[string]*element),
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "Attributes":
+			o.opaque = o.opaque || !c.elements(kv.Value, o.attributes)
+		case "Blocks":
+			o.opaque = o.opaque || !c.elements(kv.Value, o.blocks)
+		}
+	}
+
+	return o
+}
+
+// elements parses a map of attributes or blocks, returning false if the map cannot be followed.
+func (c *checker) elements(expr ast.Expr, elements map[string]*element) bool {
+	lit := c.compositeLit(expr)
+	if lit == nil {
+		return false
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return false
+		}
+		tv, ok := c.pkg.TypesInfo.Types[kv.Key]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return false
+		}
+
+		elements[constant.StringVal(tv.Value)] = c.element(kv.Value)
+	}
+
+	return true
+}
+
+// element parses a schema attribute or block.
+func (c *checker) element(expr ast.Expr) *element {
+	e := &element{
+		pos:      expr.Pos(),
+		maxItems: -1,
+	}
+
+	if named, ok := types.Unalias(c.pkg.TypesInfo.TypeOf(expr)).(*types.Named); ok {
+		if _, ok := named.Underlying().(*types.Struct); ok {
+			e.typeName = named.Obj().Name()
+		}
+	}
+
+	lit := c.compositeLit(expr)
+	if lit == nil {
+		e.opaque = true
+		return e
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "CustomType":
+			e.customType = c.pkg.TypesInfo.TypeOf(kv.Value)
+		case "Required":
+			e.required = c.isTrue(kv.Value)
+		case "Optional":
+			e.optional = c.isTrue(kv.Value)
+		case "Computed":
+			e.computed = c.isTrue(kv.Value)
+		case "Validators":
+			e.maxItems = c.maxItems(kv.Value)
+		case "NestedObject":
+			e.nested = c.object(kv.Value)
+		}
+	}
+
+	// Single nested attributes and blocks have their attributes and blocks directly.
+	if strings.HasPrefix(e.typeName, "SingleNested") {
+		e.nested = c.object(lit)
+	}
+
+	return e
+}
+
+func (c *checker) isTrue(expr ast.Expr) bool {
+	tv, ok := c.pkg.TypesInfo.Types[expr]
+
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.Bool && constant.BoolVal(tv.Value)
+}
+
+// maxItems returns the maximum size set by a list of SizeAtMost or SizeBetween validators, or -1.
+func (c *checker) maxItems(expr ast.Expr) int {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok {
+		return -1
+	}
+
+	for _, elt := range lit.Elts {
+		call, ok := elt.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+
+		var arg ast.Expr
+		switch {
+		case sel.Sel.Name == "SizeAtMost" && len(call.Args) == 1:
+			arg = call.Args[0]
+		case sel.Sel.Name == "SizeBetween" && len(call.Args) == 2:
+			arg = call.Args[1]
+		default:
+			continue
+		}
+
+		if tv, ok := c.pkg.TypesInfo.Types[arg]; ok && tv.Value != nil {
+			if v, ok := constant.Int64Val(tv.Value); ok {
+				return int(v)
+			}
+		}
+	}
+
+	return -1
+}
+
+// modelFields returns the fields of a model struct that AutoFlex maps, including those of
+// embedded model structs in the package. Embedded structs from other packages, such as
+// framework.WithRegionModel, are not part of the resource's own schema.
+func (c *checker) modelFields(s *types.Struct) []modelField {
+	var fields []modelField
+
+	for i := range s.NumFields() {
+		v := s.Field(i)
+		tag := reflect.StructTag(s.Tag(i))
+
+		if v.Embedded() {
+			if named, ok := types.Unalias(deref(v.Type())).(*types.Named); ok && named.Obj().Pkg() == c.pkg.Types {
+				if s, ok := named.Underlying().(*types.Struct); ok {
+					fields = append(fields, c.modelFields(s)...)
+				}
+			}
+			continue
+		}
+
+		tfName := tag.Get("tfsdk")
+		if !v.Exported() || tfName == "" || tfName == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag.Get("autoflex"), ",")
+		f := modelField{
+			v:      v,
+			tfName: tfName,
+		}
+		if name != "-" {
+			f.sdkName = name
+		}
+		for option := range strings.SplitSeq(options, ",") {
+			switch option, value, _ := strings.Cut(option, "="); option {
+			case "noexpand":
+				f.noExpand = true
+			case "noflatten":
+				f.noFlatten = true
+			case "xmlwrapper":
+				f.xmlWrapper = value
+			}
+		}
+		// Fields named "-" and Tags are ignored by AutoFlex.
+		if name == "-" || v.Name() == "Tags" {
+			f.noExpand, f.noFlatten = true, true
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// sdkFields returns the SDK structure fields that AutoFlex expands the model field to and flattens it from.
+func (c *checker) sdkFields(m mapping, fields []modelField, f modelField) (*types.Var, *types.Var) {
+	var in, out *types.Var
+
+	if m.input != nil && !f.noExpand {
+		in = findField(f, fields, m.input.Underlying().(*types.Struct), m.prefix, m.suffix)
+	}
+	if m.output != nil && !f.noFlatten {
+		out = findField(f, fields, m.output.Underlying().(*types.Struct), m.prefix, m.suffix)
+	}
+
+	return in, out
+}
+
+// findField finds the SDK structure field that corresponds to a model field, in the
+// same order of precedence as AutoFlex.
+func findField(f modelField, fields []modelField, s *types.Struct, prefix, suffix string) *types.Var {
+	if f.sdkName != "" {
+		return fieldByName(s, f.sdkName)
+	}
+
+	inModel := func(name string) bool {
+		return slices.ContainsFunc(fields, func(f modelField) bool {
+			return f.v.Name() == name
+		})
+	}
+
+	var find func(name string, affixes bool) *types.Var
+	find = func(name string, affixes bool) *types.Var {
+		if v := fieldByName(s, name); v != nil {
+			return v
+		}
+
+		for v := range s.Fields() {
+			if v.Exported() && strings.EqualFold(v.Name(), name) && !inModel(v.Name()) {
+				return v
+			}
+		}
+
+		if to := plural.Plural(name); plural.IsSingular(name) && !inModel(to) {
+			if v := fieldByName(s, to); v != nil {
+				return v
+			}
+		}
+		if to := plural.Singular(name); plural.IsPlural(name) && !inModel(to) {
+			if v := fieldByName(s, to); v != nil {
+				return v
+			}
+		}
+
+		if !affixes {
+			return nil
+		}
+
+		if prefix != "" {
+			if trimmed, ok := strings.CutPrefix(name, prefix); ok {
+				if v := find(trimmed, false); v != nil {
+					return v
+				}
+			} else if v := find(prefix+name, false); v != nil {
+				return v
+			}
+		}
+		if suffix != "" {
+			if trimmed, ok := strings.CutSuffix(name, suffix); ok {
+				return find(trimmed, false)
+			}
+			return find(name+suffix, false)
+		}
+
+		return nil
+	}
+
+	return find(f.v.Name(), true)
+}
+
+func fieldByName(s *types.Struct, name string) *types.Var {
+	for v := range s.Fields() {
+		if v.Name() == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// validate checks a model struct against its schema and SDK structures, recursing into nested models.
+func (c *checker) validate(m mapping) {
+	if c.visited[m] {
+		return
+	}
+	c.visited[m] = true
+
+	model := m.model.Obj().Name()
+	fields := c.modelFields(m.model.Underlying().(*types.Struct))
+	checkSchema := m.object != nil && !m.object.opaque
+	if m.object != nil && m.object.opaque {
+		c.warnf(m.model.Obj().Pos(), "%s: schema attributes or blocks are not map literals, so they are not checked", model)
+	}
+
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		name := model + "." + f.v.Name()
+		seen[f.tfName] = true
+
+		var e *element
+		if checkSchema {
+			if e = m.object.attributes[f.tfName]; e == nil {
+				e = m.object.blocks[f.tfName]
+			}
+			if e == nil {
+				c.errorf(f.v.Pos(), "%s: no schema attribute or block %q", name, f.tfName)
+			}
+		}
+
+		if e != nil {
+			c.checkElement(name, f, e)
+		}
+
+		in, out := c.sdkFields(m, fields, f)
+		var nestedIn, nestedOut *types.Named
+		if in != nil {
+			nestedIn = c.checkSDKType(name, f, in.Type(), e)
+		}
+		if out != nil {
+			nestedOut = c.checkSDKType(name, f, out.Type(), e)
+		}
+
+		nested, _ := nestedModel(f.v.Type())
+		if nested == nil || (nestedIn == nil && nestedOut == nil) {
+			continue
+		}
+
+		var nestedObject *object
+		if e != nil {
+			nestedObject = e.nested
+
+			switch configurable := in != nil; {
+			case e.typeName == "":
+			case m.input != nil && configurable && !e.block() && !e.opaque && !e.required && !e.optional:
+				c.errorf(e.pos, "%s: %s.%s is configurable, so %q must be a nested block or an optional nested attribute, not a computed %s", name, m.input.Obj().Name(), in.Name(), f.tfName, e.typeName)
+			case m.input != nil && !configurable && e.block():
+				c.errorf(e.pos, "%s: %s.%s is not in %s, so %q must be a computed nested attribute, not %s", name, m.output.Obj().Name(), out.Name(), m.input.Obj().Name(), f.tfName, e.typeName)
+			}
+		}
+
+		c.validate(mapping{
+			model:  nested,
+			input:  nestedIn,
+			output: nestedOut,
+			object: nestedObject,
+		})
+	}
+
+	if checkSchema {
+		for _, elements := range []map[string]*element{m.object.attributes, m.object.blocks} {
+			for _, key := range slices.Sorted(maps.Keys(elements)) {
+				if !seen[key] {
+					c.errorf(elements[key].pos, "%s: schema %q has no model field", model, key)
+				}
+			}
+		}
+	}
+}
+
+// checkElement checks that a schema attribute or block matches the model field's type.
+func (c *checker) checkElement(name string, f modelField, e *element) {
+	t := f.v.Type()
+
+	if kind := e.kind(); kind != "" && !c.isKind(t, kind) {
+		c.errorf(f.v.Pos(), "%s: model type %s is not a %s value, but %q is a %s", name, c.typeString(t), kind, f.tfName, e.typeName)
+		return
+	}
+
+	if e.opaque {
+		return
+	}
+
+	switch {
+	case e.customType == nil && !inPackage(named(t), basetypesPackage):
+		c.errorf(e.pos, "%s: %q has no CustomType for model type %s", name, f.tfName, c.typeString(t))
+	case e.customType != nil && !customTypeMatches(e.customType, t):
+		c.errorf(e.pos, "%s: %q has CustomType %s, which does not match model type %s", name, f.tfName, c.typeString(e.customType), c.typeString(t))
+	}
+}
+
+// customTypeMatches returns whether a schema custom type's values are of the given type.
+// The value type is named for the custom type, e.g. ARN for arnType, ListValueOf[T] for
+// listTypeOf[T] and StringEnum[T] for stringEnumTypeWithAttributeDefault[T].
+func customTypeMatches(customType, valueType types.Type) bool {
+	ct, vt := named(customType), named(valueType)
+	if ct == nil || vt == nil || ct.Obj().Pkg() != vt.Obj().Pkg() {
+		return false
+	}
+
+	name := strings.TrimSuffix(ct.Obj().Name(), "WithAttributeDefault")
+	if v, ok := strings.CutSuffix(name, "TypeOf"); ok {
+		name = v + "ValueOf"
+	} else {
+		name = strings.TrimSuffix(name, "Type")
+	}
+	if !strings.EqualFold(name, vt.Obj().Name()) && !strings.EqualFold(name+"Value", vt.Obj().Name()) {
+		return false
+	}
+
+	if ct.TypeArgs().Len() != vt.TypeArgs().Len() {
+		return false
+	}
+	for i := range ct.TypeArgs().Len() {
+		if !types.Identical(ct.TypeArgs().At(i), vt.TypeArgs().At(i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkSDKType checks that a model field's type is compatible with the SDK field's type.
+// It returns the SDK structure that a nested model maps to, if any.
+func (c *checker) checkSDKType(name string, f modelField, sdkType types.Type, e *element) *types.Named {
+	t := deref(types.Unalias(sdkType))
+	if f.xmlWrapper != "" {
+		if s, ok := t.Underlying().(*types.Struct); ok {
+			if v := fieldByName(s, f.xmlWrapper); v != nil {
+				t = v.Type()
+			}
+		}
+	}
+
+	modelType := f.v.Type()
+	nested, collection := nestedModel(modelType)
+
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		elem := deref(types.Unalias(u.Elem()))
+
+		switch {
+		case isEnum(elem):
+			if !isEnumCollection(modelType, elem) {
+				c.errorf(f.v.Pos(), "%s: SDK type %s is a list of enums; use fwtypes.ListOfStringEnum[%[3]s] or fwtypes.SetOfStringEnum[%[3]s], not %s", name, c.typeString(t), c.typeString(elem), c.typeString(modelType))
+			}
+		case isSDKStruct(elem):
+			if nested == nil || collection == "Object" {
+				c.errorf(f.v.Pos(), "%s: SDK type %s is a list of structures; use fwtypes.ListNestedObjectValueOf or fwtypes.SetNestedObjectValueOf, not %s", name, c.typeString(t), c.typeString(modelType))
+				return nil
+			}
+			return elem.(*types.Named)
+		case isBasic(elem, types.Byte):
+			c.checkKind(name, f, t, "String")
+		default:
+			c.checkKind(name, f, t, "List", "Set")
+		}
+
+	case *types.Map:
+		c.checkKind(name, f, t, "Map")
+
+	case *types.Struct:
+		switch {
+		case isTime(t):
+			c.checkKind(name, f, t, "String")
+		case isSDKStruct(t):
+			if nested == nil {
+				c.errorf(f.v.Pos(), "%s: SDK type %s is a structure; use fwtypes.ListNestedObjectValueOf, fwtypes.SetNestedObjectValueOf or fwtypes.ObjectValueOf, not %s", name, c.typeString(t), c.typeString(modelType))
+				return nil
+			}
+			if collection != "Object" && e != nil && !e.opaque && (e.block() || !e.computed || e.optional) && e.maxItems != 1 {
+				c.errorf(e.pos, "%s: SDK type %s is a single structure, so %q must have a %svalidator.SizeAtMost(1) validator", name, c.typeString(t), f.tfName, strings.ToLower(collection))
+			}
+			return t.(*types.Named)
+		}
+
+	case *types.Basic:
+		switch {
+		case isEnum(t):
+			if !isStringEnumOf(modelType, t) {
+				c.errorf(f.v.Pos(), "%s: SDK type %s is an enum; use fwtypes.StringEnum[%[2]s], not %s", name, c.typeString(t), c.typeString(modelType))
+			}
+		case u.Info()&types.IsString != 0:
+			c.checkKind(name, f, t, "String")
+		case u.Info()&types.IsBoolean != 0:
+			c.checkKind(name, f, t, "Bool")
+		case u.Kind() == types.Int32:
+			c.checkKind(name, f, t, "Int32", "Int64")
+		case u.Info()&types.IsInteger != 0:
+			c.checkKind(name, f, t, "Int64")
+		case u.Info()&types.IsFloat != 0:
+			c.checkKind(name, f, t, "Float64", "Float32")
+		}
+	}
+
+	return nil
+}
+
+// checkKind checks that a model field's type is one of the given kinds.
+func (c *checker) checkKind(name string, f modelField, sdkType types.Type, kinds ...string) {
+	if slices.ContainsFunc(kinds, func(kind string) bool {
+		return c.isKind(f.v.Type(), kind)
+	}) {
+		return
+	}
+
+	c.errorf(f.v.Pos(), "%s: SDK type %s requires a %s model type, not %s", name, c.typeString(sdkType), strings.Join(kinds, " or "), c.typeString(f.v.Type()))
+}
+
+// isKind returns whether t implements the basetypes Valuable interface for the given kind, e.g. basetypes.StringValuable.
+func (c *checker) isKind(t types.Type, kind string) bool {
+	basetypes := c.basetypes()
+	if basetypes == nil {
+		return true
+	}
+
+	typeName, ok := basetypes.Scope().Lookup(kind + "Valuable").(*types.TypeName)
+	if !ok {
+		return true
+	}
+	iface, ok := typeName.Type().Underlying().(*types.Interface)
+	if !ok {
+		return true
+	}
+
+	return types.Implements(t, iface) || types.Implements(types.NewPointer(t), iface)
+}
+
+// kindOf returns the kind of value that t is, e.g. String or List.
+func (c *checker) kindOf(t types.Type) string {
+	for _, kind := range kinds {
+		if c.isKind(t, kind) {
+			return kind
+		}
+	}
+
+	return ""
+}
+
+// basetypes returns the terraform-plugin-framework basetypes package, which the service package imports indirectly.
+func (c *checker) basetypes() *types.Package {
+	seen := make(map[*types.Package]bool)
+	queue := []*types.Package{c.pkg.Types}
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if p.Path() == basetypesPackage {
+			return p
+		}
+		for _, p := range p.Imports() {
+			if !seen[p] {
+				seen[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+
+	return nil
+}
+
+// requiredFields returns the names of an SDK structure's fields that are documented as required.
+// The SDK's source is parsed for its documentation, as only its types are loaded.
+func (c *checker) requiredFields(t *types.Named) map[string]bool {
+	if fields, ok := c.required[t]; ok {
+		return fields
+	}
+
+	fields := make(map[string]bool)
+	c.required[t] = fields
+
+	filename := c.pkg.Fset.Position(t.Obj().Pos()).Filename
+	if filename == "" {
+		return fields
+	}
+	file, ok := c.sdkFiles[filename]
+	if !ok {
+		file, _ = parser.ParseFile(token.NewFileSet(), filename, nil, parser.ParseComments)
+		c.sdkFiles[filename] = file
+	}
+	if file == nil {
+		return fields
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		if typeSpec.Name.Name != t.Obj().Name() {
+			return false
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+
+		for _, field := range structType.Fields.List {
+			if field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMarker) {
+				for _, name := range field.Names {
+					fields[name.Name] = true
+				}
+			}
+		}
+
+		return false
+	})
+
+	return fields
+}
+
+// typeString returns t as written in the package's source, recording the packages it references.
+func (c *checker) typeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		return c.packageName(p.Path(), p.Name())
+	})
+}
+
+// packageName returns the name that generated code refers to a package by, recording it as imported.
+func (c *checker) packageName(path, defaultName string) string {
+	if path == c.pkg.Types.Path() {
+		return ""
+	}
+	if name, ok := templateImports[path]; ok {
+		return name
+	}
+
+	name, ok := c.importNames[path]
+	if !ok {
+		name = defaultName
+	}
+	c.imports[path] = name
+
+	return name
+}
+
+// generatedImports returns the import specs for the packages referenced by generated code.
+func (c *checker) generatedImports() []string {
+	var specs []string
+
+	for _, path := range slices.Sorted(maps.Keys(c.imports)) {
+		if name := c.imports[path]; name != filepath.Base(path) {
+			specs = append(specs, fmt.Sprintf("%s %q", name, path))
+		} else {
+			specs = append(specs, strconv.Quote(path))
+		}
+	}
+
+	return specs
+}
+
+// function is a pair of generated functions returning a model's schema attributes and blocks.
+type function struct {
+	Model      string
+	Source     string
+	Attributes string
+	Blocks     string
+	// AttributeExprs and BlockExprs are the map entries, e.g. `"name": schema.StringAttribute{...}`.
+	AttributeExprs []string
+	BlockExprs     []string
+	Unmapped       []string
+}
+
+type generator struct {
+	*checker
+	functions []*function
+	byModel   map[*types.Named]*function
+}
+
+func newGenerator(c *checker) *generator {
+	return &generator{
+		checker: c,
+		byModel: make(map[*types.Named]*function),
+	}
+}
+
+// function generates the schema functions for a model and, first, for its nested models.
+func (g *generator) function(m mapping) *function {
+	if fn, ok := g.byModel[m.model]; ok {
+		return fn
+	}
+
+	model := m.model.Obj().Name()
+	base := strings.TrimSuffix(model, "Model")
+	fn := &function{
+		Model:      model,
+		Attributes: base + "Attributes",
+		Blocks:     base + "Blocks",
+	}
+	g.byModel[m.model] = fn
+
+	var sources []string
+	for _, t := range []*types.Named{m.input, m.output} {
+		if t == nil {
+			continue
+		}
+		if source := g.typeString(t); !slices.Contains(sources, source) {
+			sources = append(sources, source)
+		}
+	}
+	fn.Source = strings.Join(sources, " and ")
+
+	for _, name := range []string{fn.Attributes, fn.Blocks} {
+		if obj := g.pkg.Types.Scope().Lookup(name); obj != nil {
+			g.errorf(obj.Pos(), "%s: generated function %s is already declared", model, name)
+		}
+	}
+
+	fields := g.modelFields(m.model.Underlying().(*types.Struct))
+	for _, f := range fields {
+		in, out := g.sdkFields(m, fields, f)
+		required := in != nil && m.input != nil && g.requiredFields(m.input)[in.Name()]
+
+		attribute, block, note := g.field(f, in, out, required)
+		switch {
+		case attribute != "":
+			fn.AttributeExprs = append(fn.AttributeExprs, fmt.Sprintf("%q: %s", f.tfName, attribute))
+		case block != "":
+			fn.BlockExprs = append(fn.BlockExprs, fmt.Sprintf("%q: %s", f.tfName, block))
+		}
+		if note != "" {
+			fn.Unmapped = append(fn.Unmapped, fmt.Sprintf("%s: %s", f.v.Name(), note))
+		}
+	}
+
+	g.functions = append(g.functions, fn)
+
+	return fn
+}
+
+// field generates the schema attribute or block for a model field, noting why if the field
+// has no SDK counterpart or cannot be generated.
+func (g *generator) field(f modelField, in, out *types.Var, required bool) (string, string, string) {
+	t := f.v.Type()
+
+	if in == nil && out == nil {
+		switch f.tfName {
+		case "id":
+			return "framework.IDAttribute()", "", ""
+		case "tags":
+			return "tftags.TagsAttribute()", "", ""
+		case "tags_all":
+			return "tftags.TagsAttributeComputedOnly()", "", ""
+		}
+	}
+
+	var sdkType types.Type
+	switch {
+	case in != nil:
+		sdkType = in.Type()
+	case out != nil:
+		sdkType = out.Type()
+	}
+
+	var note string
+	if sdkType == nil {
+		note = "not in the SDK types; generated as computed"
+	}
+
+	if nested, collection := nestedModel(t); nested != nil {
+		return g.nested(f, nested, collection, in, out, required, note)
+	}
+
+	kind := g.kindOf(t)
+	if kind == "" || kind == "Object" || kind == "Dynamic" {
+		return "", "", fmt.Sprintf("model type %s is not supported", g.typeString(t))
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "schema.%sAttribute{\n", kind)
+	if v, ok := g.customTypeExpr(t); ok {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", v)
+	} else if v != "" {
+		fmt.Fprintf(&sb, "// TODO Set CustomType for %s.\n", v)
+	}
+	if kind == "List" || kind == "Set" || kind == "Map" {
+		if v := g.elementTypeExpr(t, sdkType); v != "" {
+			fmt.Fprintf(&sb, "ElementType: %s,\n", v)
+		} else {
+			fmt.Fprintf(&sb, "// TODO Set ElementType.\n")
+		}
+	}
+	sb.WriteString(flags(in, out, required))
+	sb.WriteString("}")
+
+	return sb.String(), "", note
+}
+
+// nested generates a nested block for a configurable nested model, or a computed attribute otherwise.
+func (g *generator) nested(f modelField, nested *types.Named, collection string, in, out *types.Var, required bool, note string) (string, string, string) {
+	model := g.typeString(nested)
+
+	if in == nil {
+		if collection == "Object" {
+			return fmt.Sprintf("schema.ObjectAttribute{\nCustomType: fwtypes.NewObjectTypeOf[%[1]s](ctx),\nAttributeTypes: fwtypes.AttributeTypesMust[%[1]s](ctx),\nComputed: true,\n}", model), "", note
+		}
+		return fmt.Sprintf("schema.%[1]sAttribute{\nCustomType: fwtypes.New%[1]sNestedObjectTypeOf[%[2]s](ctx),\nElementType: fwtypes.NewObjectTypeOf[%[2]s](ctx),\nComputed: true,\n}", collection, model), "", note
+	}
+
+	var structs [2]*types.Named
+	single := false
+	for i, v := range []*types.Var{in, out} {
+		if v == nil {
+			continue
+		}
+		t := deref(types.Unalias(v.Type()))
+		if f.xmlWrapper != "" {
+			if s, ok := t.Underlying().(*types.Struct); ok {
+				if w := fieldByName(s, f.xmlWrapper); w != nil {
+					t = w.Type()
+				}
+			}
+		}
+		if s, ok := t.Underlying().(*types.Slice); ok {
+			t = deref(types.Unalias(s.Elem()))
+		} else if i == 0 {
+			single = true
+		}
+		if !isSDKStruct(t) {
+			return "", "", fmt.Sprintf("SDK type %s is not a structure", g.typeString(v.Type()))
+		}
+		structs[i] = t.(*types.Named)
+	}
+
+	fn := g.function(mapping{
+		model:  nested,
+		input:  structs[0],
+		output: structs[1],
+	})
+
+	var body strings.Builder
+	if len(fn.AttributeExprs) > 0 {
+		fmt.Fprintf(&body, "Attributes: %s(ctx),\n", fn.Attributes)
+	}
+	if len(fn.BlockExprs) > 0 {
+		fmt.Fprintf(&body, "Blocks: %s(ctx),\n", fn.Blocks)
+	}
+
+	if collection == "Object" {
+		return "", fmt.Sprintf("schema.SingleNestedBlock{\nCustomType: fwtypes.NewObjectTypeOf[%s](ctx),\n%s}", model, body.String()), note
+	}
+
+	var sb strings.Builder
+	validator := strings.ToLower(collection) + "validator"
+	fmt.Fprintf(&sb, "schema.%sNestedBlock{\n", collection)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", collection, model)
+	if required || single {
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n", collection)
+		if required {
+			fmt.Fprintf(&sb, "%s.IsRequired(),\n", validator)
+		}
+		if single {
+			fmt.Fprintf(&sb, "%s.SizeAtMost(1),\n", validator)
+		}
+		sb.WriteString("},\n")
+	}
+	fmt.Fprintf(&sb, "NestedObject: schema.NestedBlockObject{\n%s},\n}", body.String())
+
+	return "", sb.String(), note
+}
+
+// flags returns the Required, Optional and Computed fields of a generated attribute.
+// Fields not in the input are computed, and fields in both the input and output are optional and computed.
+func flags(in, out *types.Var, required bool) string {
+	switch {
+	case in == nil:
+		return "Computed: true,\n"
+	case required:
+		return "Required: true,\n"
+	case out != nil:
+		return "Optional: true,\nComputed: true,\n"
+	default:
+		return "Optional: true,\n"
+	}
+}
+
+// customTypeExpr returns the expression for the schema custom type of a model type.
+// It returns false and the model type if the custom type cannot be determined, and
+// false and an empty string if the model type is a basetypes value, which needs no custom type.
+func (g *generator) customTypeExpr(t types.Type) (string, bool) {
+	n := named(t)
+	if n == nil || inPackage(n, basetypesPackage) {
+		return "", false
+	}
+
+	name := n.Obj().Name()
+	var args []string
+	for arg := range n.TypeArgs().Types() {
+		args = append(args, g.typeString(arg))
+	}
+
+	if inPackage(n, fwtypesPackage) {
+		switch name {
+		case "StringEnum":
+			return fmt.Sprintf("fwtypes.StringEnumType[%s]()", args[0]), true
+		case "ListValueOf", "SetValueOf", "MapValueOf":
+			collection := strings.TrimSuffix(name, "ValueOf")
+			elem := named(n.TypeArgs().At(0))
+			switch {
+			case elem == nil:
+			case inPackage(elem, fwtypesPackage) && elem.Obj().Name() == "StringEnum" && collection != "Map":
+				return fmt.Sprintf("fwtypes.%sOfStringEnumType[%s]()", collection, g.typeString(elem.TypeArgs().At(0))), true
+			default:
+				v := strings.TrimSuffix(elem.Obj().Name(), "Value")
+				if g.fwtypesVar(collection + "Of" + v + "Type") {
+					return fmt.Sprintf("fwtypes.%sOf%sType", collection, v), true
+				}
+			}
+			if collection != "List" {
+				return fmt.Sprintf("fwtypes.New%sTypeOf[%s](ctx)", collection, args[0]), true
+			}
+		default:
+			if g.fwtypesVar(name + "Type") {
+				return fmt.Sprintf("fwtypes.%sType", name), true
+			}
+		}
+
+		return g.typeString(t), false
+	}
+
+	// Custom types in other packages, e.g. timetypes.RFC3339Type, are named for their values.
+	if _, ok := n.Obj().Pkg().Scope().Lookup(name + "Type").(*types.TypeName); ok && len(args) == 0 {
+		return fmt.Sprintf("%s.%sType{}", g.packageName(n.Obj().Pkg().Path(), n.Obj().Pkg().Name()), name), true
+	}
+
+	return g.typeString(t), false
+}
+
+// elementTypeExpr returns the expression for the element type of a list, set or map model type.
+func (g *generator) elementTypeExpr(t, sdkType types.Type) string {
+	n := named(t)
+	if n == nil {
+		return ""
+	}
+
+	if inPackage(n, fwtypesPackage) && n.TypeArgs().Len() == 1 {
+		elem := n.TypeArgs().At(0)
+		if e := named(elem); e != nil && inPackage(e, basetypesPackage) {
+			return fmt.Sprintf("types.%sType", strings.TrimSuffix(e.Obj().Name(), "Value"))
+		}
+		if v, ok := g.customTypeExpr(elem); ok {
+			return v
+		}
+		return ""
+	}
+
+	// For basetypes collections, the element type is that of the SDK type.
+	var elem types.Type
+	switch u := deref(types.Unalias(sdkType)).Underlying().(type) {
+	case *types.Slice:
+		elem = u.Elem()
+	case *types.Map:
+		elem = u.Elem()
+	default:
+		return ""
+	}
+
+	if b, ok := deref(types.Unalias(elem)).(*types.Basic); ok {
+		switch {
+		case b.Info()&types.IsString != 0:
+			return "types.StringType"
+		case b.Info()&types.IsBoolean != 0:
+			return "types.BoolType"
+		case b.Kind() == types.Int32:
+			return "types.Int32Type"
+		case b.Info()&types.IsInteger != 0:
+			return "types.Int64Type"
+		case b.Kind() == types.Float32:
+			return "types.Float32Type"
+		case b.Info()&types.IsFloat != 0:
+			return "types.Float64Type"
+		}
+	}
+
+	return ""
+}
+
+func (g *generator) fwtypesVar(name string) bool {
+	p := g.importedPackage(fwtypesPackage)
+	if p == nil {
+		return false
+	}
+
+	_, ok := p.Scope().Lookup(name).(*types.Var)
+
+	return ok
+}
+
+// nestedModel returns the model struct of a nested object model type and whether it is
+// a List, Set or (single) Object.
+func nestedModel(t types.Type) (*types.Named, string) {
+	n := named(t)
+	if n == nil || !inPackage(n, fwtypesPackage) || n.TypeArgs().Len() != 1 {
+		return nil, ""
+	}
+
+	var collection string
+	switch n.Obj().Name() {
+	case "ListNestedObjectValueOf":
+		collection = "List"
+	case "SetNestedObjectValueOf":
+		collection = "Set"
+	case "ObjectValueOf":
+		collection = "Object"
+	default:
+		return nil, ""
+	}
+
+	model, ok := n.TypeArgs().At(0).(*types.Named)
+	if !ok {
+		return nil, ""
+	}
+
+	return model, collection
+}
+
+// isStringEnumOf returns whether t is fwtypes.StringEnum[enum].
+func isStringEnumOf(t, enum types.Type) bool {
+	n := named(t)
+
+	return n != nil && inPackage(n, fwtypesPackage) && n.Obj().Name() == "StringEnum" && types.Identical(n.TypeArgs().At(0), enum)
+}
+
+// isEnumCollection returns whether t is a list or set of fwtypes.StringEnum[enum].
+func isEnumCollection(t, enum types.Type) bool {
+	n := named(t)
+	if n == nil || !inPackage(n, fwtypesPackage) || n.TypeArgs().Len() != 1 {
+		return false
+	}
+
+	switch n.Obj().Name() {
+	case "ListValueOf", "SetValueOf", "MultisetValueOf":
+		return isStringEnumOf(n.TypeArgs().At(0), enum)
+	}
+
+	return false
+}
+
+// isEnum returns whether t is an SDK string enum type, which has a Values method.
+func isEnum(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	if !ok || !isSDKType(n) {
+		return false
+	}
+	if b, ok := n.Underlying().(*types.Basic); !ok || b.Info()&types.IsString == 0 {
+		return false
+	}
+
+	for m := range n.Methods() {
+		if m.Name() == "Values" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSDKStruct(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	if !ok || !isSDKType(n) {
+		return false
+	}
+	_, ok = n.Underlying().(*types.Struct)
+
+	return ok
+}
+
+func isSDKType(n *types.Named) bool {
+	return n.Obj().Pkg() != nil && strings.HasPrefix(n.Obj().Pkg().Path(), sdkPackagePrefix)
+}
+
+func isTime(t types.Type) bool {
+	n, ok := t.(*types.Named)
+
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "time" && n.Obj().Name() == "Time"
+}
+
+func isBasic(t types.Type, kind types.BasicKind) bool {
+	b, ok := t.(*types.Basic)
+
+	return ok && b.Kind() == kind
+}
+
+func inPackage(n *types.Named, path string) bool {
+	return n != nil && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == path
+}
+
+// named returns the named type that t is or is an alias of, if any.
+func named(t types.Type) *types.Named {
+	n, _ := types.Unalias(t).(*types.Named)
+
+	return n
+}
+
+func deref(t types.Type) types.Type {
+	if p, ok := t.(*types.Pointer); ok {
+		return p.Elem()
+	}
+
+	return t
+}
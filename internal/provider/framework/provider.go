@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -82,6 +83,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_report_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a summary of the AWS API calls made by the provider is written when the provider exits. Can also be configured using the `" + apicall.ReportPathEnvVar + "` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -182,6 +187,13 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `The path of a local JSON file containing a tag policy, in the AWS Organizations tag policy syntax. ` +
+					`When set, tag policy compliance is checked against this file instead of the organizational tag policies ` +
+					`attached to the target account, and no AWS Organizations API calls are made. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -327,6 +339,33 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration block with settings to limit the rate of AWS API requests made to a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"adaptive": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Reduce the request rate when throttling errors are returned, recovering gradually as requests succeed.",
+						},
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests made without waiting. Defaults to `requests_per_second`, rounded up.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The API operation name, e.g. `ChangeResourceRecordSets`. If omitted, the limit applies to all of the service's operations.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The maximum sustained request rate.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, using the same names as the `endpoints` configuration block, e.g. `route53`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -397,6 +436,16 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetPlanFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewEC2PrivateDNSNameFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewPartitionHostnameFunction,
+		tffunction.NewRegionalHostnameFunction,
+		func() function.Function {
+			return tffunction.NewServiceEndpointFunction(p.servicePackages)
+		},
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
@@ -333,3 +333,74 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 		}
 	}
 }
+
+// resourceValidateTagPolicyRules validates that tags comply with the tag key capitalization and value rules of the tag policy.
+func resourceValidateTagPolicyRules() resourceModifyPlanInterceptor {
+	return &resourceValidateTagPolicyRulesInterceptor{}
+}
+
+type resourceValidateTagPolicyRulesInterceptor struct{}
+
+func (r resourceValidateTagPolicyRulesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c) //nolint:dogsled // legitimate use as-is, signature to be refactored
+	if !ok {
+		return
+	}
+
+	policy := c.TagPolicyConfig(ctx)
+	if policy == nil || len(policy.TagRules) == 0 {
+		return
+	}
+
+	switch request, _, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		var planTags, stateTags tftags.Map
+		opts.response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+		opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTags), &stateTags)...)
+		if opts.response.Diagnostics.HasError() {
+			return
+		}
+
+		if !planTags.IsWhollyKnown() {
+			return
+		}
+
+		allPlanTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+		allStateTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, stateTags))
+
+		isCreate := request.State.Raw.IsNull()
+		hasTagsChange := !allPlanTags.Equal(allStateTags)
+
+		if !isCreate && !hasTagsChange {
+			return
+		}
+
+		enforced, reported := policy.TagRuleViolations(typeName, allPlanTags)
+
+		summary := "Non-Compliant Tags"
+		if len(reported) > 0 {
+			detail := fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, reported)
+			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+		}
+
+		if len(enforced) == 0 {
+			return
+		}
+
+		detail := fmt.Sprintf("An organizational tag policy prevents the following tags for %s: %s", typeName, enforced)
+
+		switch policy.Severity {
+		case "warning":
+			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+		default:
+			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		}
+	}
+}
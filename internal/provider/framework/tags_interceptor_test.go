@@ -409,3 +409,148 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+type mockTagRulesClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockTagRulesClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
+	return &tftags.TagPolicyConfig{
+		Severity: "error",
+		TagRules: []tftags.TagPolicyRule{
+			{
+				Key:         "CostCenter",
+				Values:      []string{"100", "200"},
+				EnforcedFor: []string{"aws_test"},
+			},
+			{
+				Key:    "Project",
+				Values: []string{"Alpha"},
+			},
+		},
+	}
+}
+
+func Test_resourceValidateTagPolicyRulesInterceptor(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, "Test", "test", "aws_test", "")
+		if v, ok := meta.(awsClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx), v.TagPolicyConfig(ctx))
+		}
+
+		return ctx
+	}
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": tftags.TagsAttribute(),
+		},
+	}
+
+	rawValue := func(tags map[string]string) tftypes.Value {
+		var v tftypes.Value
+		if tags == nil {
+			v = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+		} else {
+			m := make(map[string]tftypes.Value, len(tags))
+			for k, s := range tags {
+				m[k] = tftypes.NewValue(tftypes.String, s)
+			}
+			v = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, m)
+		}
+
+		return tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": v,
+		})
+	}
+	nullValue := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)
+
+	tests := []struct {
+		name      string
+		state     tftypes.Value
+		plan      tftypes.Value
+		wantDiags diag.Diagnostics
+	}{
+		{
+			name:  "create, compliant tags",
+			state: nullValue,
+			plan:  rawValue(map[string]string{"CostCenter": "100", "Project": "Alpha"}),
+		},
+		{
+			name:  "create, enforced value",
+			state: nullValue,
+			plan:  rawValue(map[string]string{"CostCenter": "300"}),
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags),
+				"Non-Compliant Tags",
+				`An organizational tag policy prevents the following tags for aws_test: [tag "CostCenter" value "300" must be one of: 100, 200]`,
+			)},
+		},
+		{
+			name:  "create, reported value",
+			state: nullValue,
+			plan:  rawValue(map[string]string{"project": "Alpha"}),
+			wantDiags: diag.Diagnostics{diag.NewAttributeWarningDiagnostic(
+				path.Root(names.AttrTags),
+				"Non-Compliant Tags",
+				`The following tags for aws_test do not comply with an organizational tag policy: [tag key "project" must be capitalized as "Project"]`,
+			)},
+		},
+		{
+			name:  "update, no tag changes",
+			state: rawValue(map[string]string{"CostCenter": "300"}),
+			plan:  rawValue(map[string]string{"CostCenter": "300"}),
+		},
+		{
+			name:  "delete",
+			state: rawValue(map[string]string{"CostCenter": "300"}),
+			plan:  nullValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockTagRulesClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tt.state,
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    tt.plan,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			}
+
+			r := resourceValidateTagPolicyRules()
+			ctx := bootstrapContext(ctx, opts.c)
+			r.modifyPlan(ctx, opts)
+
+			if !opts.response.Diagnostics.Equal(tt.wantDiags) {
+				t.Errorf("response diagnostics not equal. got: %s want: %s", opts.response.Diagnostics, tt.wantDiags)
+			}
+		})
+	}
+}
@@ -558,6 +558,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
+		interceptors = append(interceptors, resourceValidateTagPolicyRules())
 	}
 
 	inner, _ := spec.Factory(context.TODO())
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -69,6 +70,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_call_report_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a file to which a summary of the AWS API calls made by the provider is written when the provider exits. " +
+						"Can also be configured using the `" + apicall.ReportPathEnvVar + "` environment variable.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -175,6 +182,40 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration block with settings to limit the rate of AWS API requests made to a service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"adaptive": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Reduce the request rate when throttling errors are returned, recovering gradually as requests succeed.",
+							},
+							"burst": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "The maximum number of requests made without waiting. Defaults to `requests_per_second`, rounded up.",
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The API operation name, e.g. `ChangeResourceRecordSets`. If omitted, the limit applies to all of the service's operations.",
+							},
+							"requests_per_second": {
+								Type:        schema.TypeFloat,
+								Required:    true,
+								Description: "The maximum sustained request rate.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service, using the same names as the `endpoints` configuration block, e.g. `route53`.",
+							},
+						},
+					},
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -260,6 +301,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The path of a local JSON file containing a tag policy, in the AWS Organizations tag policy syntax. ` +
+						`When set, tag policy compliance is checked against this file instead of the organizational tag policies ` +
+						`attached to the target account, and no AWS Organizations API calls are made. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -373,6 +422,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.RetryMode = mode
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok && len(v.([]any)) > 0 {
+		config.RateLimits = expandRateLimits(v.([]any))
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		endpoint := conns.NormalizeS3USEast1RegionalEndpoint(v)
 		if endpoint == "legacy" {
@@ -394,6 +447,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.Get("api_call_report_path").(string); ok && v != "" {
+		config.APICallReportPath = v
+	} else {
+		config.APICallReportPath = os.Getenv(apicall.ReportPathEnvVar)
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -474,7 +533,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
-	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
+	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string), d.Get("tag_policy_file").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
 		return nil, diags
@@ -714,6 +773,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: validateRequiredTags(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateTagPolicyRules(),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -1097,13 +1161,40 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
-func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+func expandRateLimits(tfList []any) []conns.RateLimit {
+	var apiObjects []conns.RateLimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.RateLimit{
+			Adaptive:          tfMap["adaptive"].(bool),
+			Burst:             tfMap["burst"].(int),
+			Operation:         tfMap["operation"].(string),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Service:           tfMap["service"].(string),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTagPolicyConfig(path cty.Path, severity, file string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+	if file == "" {
+		file = os.Getenv(tftags.TagPolicyFileEnvVar)
+	}
+
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		return &tftags.TagPolicyConfig{Severity: severity, File: file}, validateTagPolicySeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		return &tftags.TagPolicyConfig{Severity: envSeverity, File: file}, validateTagPolicySeverityEnvVar(envSeverity)
 	}
 
 	return nil, nil
@@ -391,3 +391,69 @@ func validateRequiredTags() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+func validateTagPolicyRules() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		_, _, _, typeName, _, ok := interceptors.InfoFromContext(ctx, c)
+		if !ok {
+			return nil
+		}
+
+		policy := c.TagPolicyConfig(ctx)
+		if policy == nil || len(policy.TagRules) == 0 {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				isCreate := d.GetRawState().IsNull()
+				hasTagsChange := d.HasChange(names.AttrTags)
+
+				if !isCreate && !hasTagsChange {
+					return nil
+				}
+
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
+				enforced, reported := policy.TagRuleViolations(typeName, allTags)
+
+				summary := "Non-Compliant Tags"
+				if len(reported) > 0 {
+					tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+						"summary": summary,
+						"detail":  fmt.Sprintf("The following tags for %s do not comply with an organizational tag policy: %s", typeName, reported),
+					})
+				}
+
+				if len(enforced) == 0 {
+					return nil
+				}
+
+				detail := fmt.Sprintf("An organizational tag policy prevents the following tags for %s: %s", typeName, enforced)
+
+				// CustomizeDiff does not support diagnostics (only an error return)
+				switch policy.Severity {
+				case "warning":
+					// Warning diagnostics are only logged
+					tflog.Warn(ctx, "Tag Policy Validation", map[string]any{
+						"summary": summary,
+						"detail":  detail,
+					})
+				default:
+					// Error diagnostics merge summary and detail into a single message
+					return fmt.Errorf("%s - %s", summary, detail)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -70,6 +70,7 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // When VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
+// The same applies when the context was created by [backoff.NewZeroDelayContext].
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
@@ -89,6 +90,11 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 		}
 	}
 
+	// When testing against an in-memory AWS backend, there is nothing to wait for
+	if backoff.IsZeroDelay(ctx) {
+		delay = backoff.ZeroDelay
+	}
+
 	var (
 		t                             T
 		currentState, priorState      S
@@ -21,7 +21,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
 		Dependencies: []string{
@@ -31,7 +31,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_athena_database", sweepDatabases)
 
-	resource.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
@@ -17,6 +17,7 @@ import (
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 )
 
 func RegisterSweepers() {
@@ -153,24 +154,27 @@ func sweepStacks(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 		for _, v := range page.StackSummaries {
 			name := aws.ToString(v.StackName)
-			input := cloudformation.UpdateTerminationProtectionInput{
-				EnableTerminationProtection: aws.Bool(false),
-				StackName:                   aws.String(name),
-			}
 
-			log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
-			_, err := conn.UpdateTerminationProtection(ctx, &input)
+			if !sweep.DryRun() {
+				input := cloudformation.UpdateTerminationProtectionInput{
+					EnableTerminationProtection: aws.Bool(false),
+					StackName:                   aws.String(name),
+				}
+
+				log.Printf("[INFO] Disabling termination protection for CloudFormation Stack: %s", name)
+				_, err := conn.UpdateTerminationProtection(ctx, &input)
 
-			if err != nil {
-				log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
-				continue
+				if err != nil {
+					log.Printf("[ERROR] Disabling termination protection for CloudFormation Stack (%s): %s", name, err)
+					continue
+				}
 			}
 
 			r := resourceStack()
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreationTime))))
 		}
 	}
 
@@ -49,5 +49,8 @@ var (
 	FindTrustStoreByID                         = findTrustStoreByID
 	FindVPCOriginByID                          = findVPCOriginByID
 
+	ExpandSignPolicy    = expandSignPolicy
+	SignPolicyExpiresAt = signPolicyExpiresAt
+
 	WaitDistributionDeployed = waitDistributionDeployed
 )
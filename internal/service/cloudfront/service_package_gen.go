@@ -32,6 +32,22 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 		},
 	}
 }
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newSignedCookiesEphemeralResource,
+			TypeName: "aws_cloudfront_signed_cookies",
+			Name:     "Signed Cookies",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
+		{
+			Factory:  newSignedURLEphemeralResource,
+			TypeName: "aws_cloudfront_signed_url",
+			Name:     "Signed URL",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
+	}
+}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
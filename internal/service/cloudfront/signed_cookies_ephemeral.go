@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/cloudfront/sign"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_cloudfront_signed_cookies, name="Signed Cookies")
+func newSignedCookiesEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedCookiesEphemeralResource{}, nil
+}
+
+type signedCookiesEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedCookiesEphemeralResourceModel]
+}
+
+func (e *signedCookiesEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cookies": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot(names.AttrPolicy)),
+				},
+			},
+			"key_pair_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.JSON(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot(names.AttrPolicy), path.MatchRoot(names.AttrURL)),
+				},
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (e *signedCookiesEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signedCookiesEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, err := parseSignerPrivateKey(fwflex.StringValueFromFramework(ctx, data.PrivateKey))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	signer := sign.NewCookieSigner(fwflex.StringValueFromFramework(ctx, data.KeyPairID), privateKey)
+
+	// Sign with a canned policy for the URL unless a custom policy is configured.
+	var cookies []*http.Cookie
+	var expiresAt *time.Time
+	if !data.Policy.IsNull() {
+		policy, err := expandSignPolicy(fwflex.StringValueFromFramework(ctx, data.Policy))
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err)
+			return
+		}
+
+		cookies, err = signer.SignWithPolicy(policy)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("signing CloudFront cookies: %w", err))
+			return
+		}
+		expiresAt = signPolicyExpiresAt(policy)
+	} else {
+		expires := time.Now().Add(expiresInOr(data.ExpiresIn, signedContentDefaultExpiresIn))
+
+		cookies, err = signer.Sign(fwflex.StringValueFromFramework(ctx, data.URL), expires)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("signing CloudFront cookies: %w", err))
+			return
+		}
+		expiresAt = &expires
+	}
+
+	values := make(map[string]string, len(cookies))
+	for _, v := range cookies {
+		values[v.Name] = v.Value
+	}
+
+	data.Cookies = fwflex.FlattenFrameworkStringValueMapOfString(ctx, values)
+	data.ExpiresAt = flattenSignExpiresAt(expiresAt)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedCookiesEphemeralResourceModel struct {
+	Cookies    fwtypes.MapOfString `tfsdk:"cookies"`
+	ExpiresAt  types.String        `tfsdk:"expires_at"`
+	ExpiresIn  types.Int64         `tfsdk:"expires_in"`
+	KeyPairID  types.String        `tfsdk:"key_pair_id"`
+	Policy     types.String        `tfsdk:"policy"`
+	PrivateKey types.String        `tfsdk:"private_key"`
+	URL        types.String        `tfsdk:"url"`
+}
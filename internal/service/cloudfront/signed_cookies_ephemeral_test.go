@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontSignedCookiesEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedCookiesEphemeralResourceConfig_basic(privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Key-Pair-Id": knownvalue.StringExact("K2JCJMDEHXQW5F"),
+						"CloudFront-Policy":      knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z~_-]+$`)),
+						"CloudFront-Signature":   knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z~_-]+$`)),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedCookiesEphemeral_policy(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedCookiesEphemeralResourceConfig_policy(privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies").AtMapKey("CloudFront-Key-Pair-Id"), knownvalue.StringExact("K2JCJMDEHXQW5F")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.StringExact("2099-01-01T00:00:00Z")),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedCookiesEphemeralResourceConfig_basic(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_cookies.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_cookies" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/private/*"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
+
+func testAccSignedCookiesEphemeralResourceConfig_policy(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_cookies.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_cookies" "test" {
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+
+  policy = jsonencode({
+    Statement = [{
+      Resource = "https://d111111abcdef8.cloudfront.net/private/*"
+      Condition = {
+        DateLessThan = {
+          "AWS:EpochTime" = 4070908800
+        }
+      }
+    }]
+  })
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
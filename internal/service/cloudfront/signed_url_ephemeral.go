@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/cloudfront/sign"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	signedContentDefaultExpiresIn = 1 * time.Hour
+)
+
+// @EphemeralResource(aws_cloudfront_signed_url, name="Signed URL")
+func newSignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedURLEphemeralResource{}, nil
+}
+
+type signedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedURLEphemeralResourceModel]
+}
+
+func (e *signedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot(names.AttrPolicy)),
+				},
+			},
+			"key_pair_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (e *signedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signedURLEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	privateKey, err := parseSignerPrivateKey(fwflex.StringValueFromFramework(ctx, data.PrivateKey))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	url := fwflex.StringValueFromFramework(ctx, data.URL)
+	signer := sign.NewURLSigner(fwflex.StringValueFromFramework(ctx, data.KeyPairID), privateKey)
+
+	// Sign with a canned policy unless a custom policy is configured.
+	var signedURL string
+	var expiresAt *time.Time
+	if !data.Policy.IsNull() {
+		policy, err := expandSignPolicy(fwflex.StringValueFromFramework(ctx, data.Policy))
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err)
+			return
+		}
+
+		signedURL, err = signer.SignWithPolicy(url, policy)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("signing CloudFront URL: %w", err))
+			return
+		}
+		expiresAt = signPolicyExpiresAt(policy)
+	} else {
+		expires := time.Now().Add(expiresInOr(data.ExpiresIn, signedContentDefaultExpiresIn))
+
+		signedURL, err = signer.Sign(url, expires)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("signing CloudFront URL: %w", err))
+			return
+		}
+		expiresAt = &expires
+	}
+
+	data.ExpiresAt = flattenSignExpiresAt(expiresAt)
+	data.SignedURL = types.StringValue(signedURL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedURLEphemeralResourceModel struct {
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	ExpiresIn  types.Int64  `tfsdk:"expires_in"`
+	KeyPairID  types.String `tfsdk:"key_pair_id"`
+	Policy     types.String `tfsdk:"policy"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	SignedURL  types.String `tfsdk:"signed_url"`
+	URL        types.String `tfsdk:"url"`
+}
+
+// parseSignerPrivateKey parses a PEM-encoded RSA private key in PKCS #1 or PKCS #8 form.
+func parseSignerPrivateKey(s string) (*rsa.PrivateKey, error) {
+	if key, err := sign.LoadPEMPrivKey(strings.NewReader(s)); err == nil {
+		return key, nil
+	}
+
+	key, err := sign.LoadPEMPrivKeyPKCS8(strings.NewReader(s))
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("parsing private key: not an RSA private key")
+	}
+
+	return rsaKey, nil
+}
+
+func expandSignPolicy(s string) (*sign.Policy, error) {
+	var policy sign.Policy
+
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+
+	// CloudFront requires every statement to expire.
+	for i, v := range policy.Statements {
+		if v.Condition.DateLessThan == nil {
+			return nil, fmt.Errorf("invalid policy: statement at index %d does not have a DateLessThan condition", i)
+		}
+	}
+
+	return &policy, nil
+}
+
+// signPolicyExpiresAt returns the earliest expiry of the policy's statements.
+func signPolicyExpiresAt(policy *sign.Policy) *time.Time {
+	var expiresAt *time.Time
+
+	for _, v := range policy.Statements {
+		if v := v.Condition.DateLessThan; v != nil && (expiresAt == nil || v.Time.Before(*expiresAt)) {
+			expiresAt = &v.Time
+		}
+	}
+
+	return expiresAt
+}
+
+func flattenSignExpiresAt(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+func expiresInOr(v types.Int64, d time.Duration) time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return d
+	}
+
+	return time.Duration(v.ValueInt64()) * time.Second
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandSignPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy        string
+		wantErr       bool
+		wantExpiresAt time.Time
+	}{
+		"single statement": {
+			policy:        `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Condition":{"DateLessThan":{"AWS:EpochTime":1767225600}}}]}`,
+			wantExpiresAt: time.Unix(1767225600, 0),
+		},
+		"earliest expiry": {
+			policy:        `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/a/*","Condition":{"DateLessThan":{"AWS:EpochTime":1767225600}}},{"Resource":"https://d111111abcdef8.cloudfront.net/b/*","Condition":{"DateLessThan":{"AWS:EpochTime":1767139200}}}]}`,
+			wantExpiresAt: time.Unix(1767139200, 0),
+		},
+		"no expiry": {
+			policy:  `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*"}]}`,
+			wantErr: true,
+		},
+		"no statements": {
+			policy:  `{"Statement":[]}`,
+			wantErr: true,
+		},
+		"invalid JSON": {
+			policy:  `{`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := tfcloudfront.ExpandSignPolicy(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ExpandSignPolicy() err = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			got := tfcloudfront.SignPolicyExpiresAt(policy)
+			if got == nil || !got.Equal(testCase.wantExpiresAt) {
+				t.Errorf("SignPolicyExpiresAt() = %v, want %v", got, testCase.wantExpiresAt)
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_basic(privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/private/file\.txt\?Expires=\d+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_policy(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralResourceConfig_policy(privateKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.StringExact("2099-01-01T00:00:00Z")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/private/file\.txt\?Policy=[0-9A-Za-z~_-]+&Signature=[0-9A-Za-z~_-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralResourceConfig_basic(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/private/file.txt"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+  expires_in  = 600
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
+
+func testAccSignedURLEphemeralResourceConfig_policy(privateKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/private/file.txt"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+
+  policy = jsonencode({
+    Statement = [{
+      Resource = "https://d111111abcdef8.cloudfront.net/private/*"
+      Condition = {
+        DateLessThan = {
+          "AWS:EpochTime" = 4070908800
+        }
+        IpAddress = {
+          "AWS:SourceIp" = "192.0.2.0/24"
+        }
+      }
+    }]
+  })
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
 		Name: "aws_codeartifact_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
 		Name: "aws_codeartifact_repository",
 		F:    sweepRepositories,
 	})
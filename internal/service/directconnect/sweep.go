@@ -19,17 +19,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,13 +46,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
@@ -414,6 +414,10 @@ func sweepLags(region string) error {
 
 func sweepMacSecKeys(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_dx_macsec_key")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
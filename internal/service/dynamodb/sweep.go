@@ -21,12 +21,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_table", &resource.Sweeper{
 		Name: "aws_dynamodb_table",
 		F:    sweepTables,
 	})
 
-	resource.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dynamodb_backup", &resource.Sweeper{
 		Name: "aws_dynamodb_backup",
 		F:    sweepBackups,
 	})
@@ -56,14 +56,16 @@ func sweepTables(region string) error {
 		}
 
 		for _, v := range page.TableNames {
-			input := dynamodb.UpdateTableInput{
-				DeletionProtectionEnabled: aws.Bool(false),
-				TableName:                 aws.String(v),
-			}
-			_, err := conn.UpdateTable(ctx, &input)
-
-			if err != nil {
-				log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+			if !sweep.DryRun() {
+				input := dynamodb.UpdateTableInput{
+					DeletionProtectionEnabled: aws.Bool(false),
+					TableName:                 aws.String(v),
+				}
+				_, err := conn.UpdateTable(ctx, &input)
+
+				if err != nil {
+					log.Printf("[WARN] DynamoDB Table (%s): %s", v, err)
+				}
 			}
 
 			r := resourceTable()
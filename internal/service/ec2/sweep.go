@@ -21,12 +21,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -36,12 +37,12 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_ec2_capacity_reservation", sweepCapacityReservations)
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -49,17 +50,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -67,7 +68,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -75,12 +76,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &resource.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -89,17 +90,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &resource.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -107,7 +108,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &resource.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -117,7 +118,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -125,7 +126,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &resource.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -136,7 +137,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &resource.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -145,17 +146,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &resource.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &resource.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -174,7 +175,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -184,12 +185,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &resource.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -201,12 +202,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &resource.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &resource.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -214,12 +215,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -273,7 +274,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -281,12 +282,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -294,17 +295,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -316,12 +317,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -330,7 +331,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -339,12 +340,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -354,12 +355,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -367,12 +368,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &resource.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -398,12 +399,12 @@ func RegisterSweepers() {
 	awsv2.Register("aws_ec2_secondary_network", sweepSecondaryNetworks)
 	awsv2.Register("aws_ec2_secondary_subnet", sweepSecondarySubnets, "aws_ec2_secondary_network")
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -416,22 +417,22 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -439,7 +440,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -447,7 +448,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -455,12 +456,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -500,7 +501,7 @@ func sweepCapacityReservations(ctx context.Context, client *conns.AWSClient) ([]
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreateDate)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -732,7 +733,7 @@ func sweepEBSVolumes(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreateTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -775,7 +776,7 @@ func sweepEBSSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.SnapshotId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.StartTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -1034,15 +1035,17 @@ func sweepInstances(region string) error {
 					continue
 				}
 
-				if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
-					log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+				if !sweep.DryRun() {
+					if err := disableInstanceAPIStop(ctx, conn, id, false); err != nil {
+						log.Printf("[INFO] EC2 Instance (%s): %s", id, err)
+					}
 				}
 
 				r := resourceInstance()
 				d := r.Data(nil)
 				d.SetId(id)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.LaunchTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 			}
 		}
 	}
@@ -1162,7 +1165,7 @@ func sweepKeyPairs(region string) error {
 		d := r.Data(nil)
 		d.SetId(aws.ToString(v.KeyName))
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreateTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -1202,7 +1205,7 @@ func sweepLaunchTemplates(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.LaunchTemplateId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreateTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -1243,7 +1246,7 @@ func sweepNATGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.NatGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreateTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -1483,6 +1486,10 @@ func sweepPlacementGroups(region string) error {
 
 func sweepRouteTables(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_route_table")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1586,6 +1593,10 @@ func sweepRouteTables(region string) error {
 
 func sweepSecurityGroups(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_security_group")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2047,7 +2058,7 @@ func sweepTransitGateways(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.ToString(v.TransitGatewayId))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreationTime)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
@@ -2397,7 +2408,7 @@ func sweepVPCEndpoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.CreationTimestamp)), inventory.WithTags(keyValueTags(ctx, v.Tags).Map())))
 		}
 	}
 
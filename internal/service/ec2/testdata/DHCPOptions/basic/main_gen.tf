@@ -0,0 +1,16 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_dhcp_options" "test" {
+  domain_name_servers = ["AmazonProvidedDNS"]
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
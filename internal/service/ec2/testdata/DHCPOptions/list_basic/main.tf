@@ -0,0 +1,14 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_dhcp_options" "test" {
+  count = var.resource_count
+
+  domain_name_servers = ["AmazonProvidedDNS"]
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
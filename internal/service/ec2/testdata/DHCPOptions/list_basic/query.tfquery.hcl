@@ -0,0 +1,6 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_vpc_dhcp_options" "test" {
+  provider = aws
+}
@@ -0,0 +1,30 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_dhcp_options" "test" {
+  count = var.resource_count
+
+  domain_name_servers = ["AmazonProvidedDNS"]
+
+  tags = merge(var.resource_tags, {
+    Name = "${var.rName}-${count.index}"
+  })
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
+
+variable "resource_tags" {
+  description = "Tags to set on resource"
+  type        = map(string)
+  nullable    = false
+}
@@ -0,0 +1,35 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_network_interface" "test" {
+  count = var.resource_count
+
+  region = var.region
+
+  subnet_id = aws_subnet.test.id
+}
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  region = var.region
+
+  vpc_id     = aws_vpc.test.id
+  cidr_block = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,31 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  count = var.resource_count
+
+  subnet_ids         = [aws_subnet.test[count.index].id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test[count.index].id
+}
+
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_vpc" "test" {
+  count = var.resource_count
+
+  cidr_block = "10.${count.index}.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count = var.resource_count
+
+  vpc_id     = aws_vpc.test[count.index].id
+  cidr_block = cidrsubnet(aws_vpc.test[count.index].cidr_block, 8, 0)
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
@@ -0,0 +1,75 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_endpoint_service" "test" {
+  count = var.resource_count
+
+  region = var.region
+
+  acceptance_required        = false
+  network_load_balancer_arns = [aws_lb.test[count.index].arn]
+}
+
+resource "aws_lb" "test" {
+  count = var.resource_count
+
+  region = var.region
+
+  load_balancer_type = "network"
+  name               = "${substr(var.rName, 0, 29)}-${count.index}"
+  internal           = true
+
+  subnets = aws_subnet.test[*].id
+
+  enable_deletion_protection = false
+}
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  region = var.region
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+}
+
+data "aws_availability_zones" "available" {
+  region = var.region
+
+  exclude_zone_ids = local.default_exclude_zone_ids
+  state            = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  default_exclude_zone_ids = ["usw2-az4", "usgw1-az2"]
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,26 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_peering_connection" "test" {
+  count = var.resource_count
+
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer[count.index].id
+  auto_accept = true
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "peer" {
+  count = var.resource_count
+
+  cidr_block = "10.${count.index + 1}.0.0/16"
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
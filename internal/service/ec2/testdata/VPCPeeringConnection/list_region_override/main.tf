@@ -0,0 +1,38 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc_peering_connection" "test" {
+  count = var.resource_count
+
+  region = var.region
+
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer[count.index].id
+  auto_accept = true
+}
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_vpc" "peer" {
+  count = var.resource_count
+
+  region = var.region
+
+  cidr_block = "10.${count.index + 1}.0.0/16"
+}
+
+variable "resource_count" {
+  description = "Number of resources to create"
+  type        = number
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
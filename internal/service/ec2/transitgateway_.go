@@ -33,6 +33,10 @@ import (
 // @SDKResource("aws_ec2_transit_gateway", name="Transit Gateway")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.TransitGateway")
+// @Testing(preIdentityVersion="v6.53.0")
+// @Testing(serialize=true)
 func resourceTransitGateway() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayCreate,
@@ -40,10 +44,6 @@ func resourceTransitGateway() *schema.Resource {
 		UpdateWithoutTimeout: resourceTransitGatewayUpdate,
 		DeleteWithoutTimeout: resourceTransitGatewayDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -232,6 +232,12 @@ func resourceTransitGatewayRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", d.Id(), err)
 	}
 
+	resourceTransitGatewayFlatten(ctx, transitGateway, d)
+
+	return diags
+}
+
+func resourceTransitGatewayFlatten(ctx context.Context, transitGateway *awstypes.TransitGateway, d *schema.ResourceData) {
 	d.Set("amazon_side_asn", transitGateway.Options.AmazonSideAsn)
 	d.Set(names.AttrARN, transitGateway.TransitGatewayArn)
 	d.Set("association_default_route_table_id", transitGateway.Options.AssociationDefaultRouteTableId)
@@ -260,8 +266,6 @@ func resourceTransitGatewayRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	setTagsOut(ctx, transitGateway.Tags)
-
-	return diags
 }
 
 func resourceTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -76,6 +76,10 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"vpnEcmpSupport":                                     testAccTransitGateway_vpnECMPSupport,
 			"encryptionSupportWhenCreated":                       testAccTransitGateway_encryptionSupportWhenCreated,
 			"encryptionSupportWhenUpdated":                       testAccTransitGateway_encryptionSupportWhenUpdated,
+			"Identity":                                           testAccTransitGateway_identity,
+			"ListBasic":                                          testAccTransitGateway_List_basic,
+			"ListIncludeResource":                                testAccTransitGateway_List_includeResource,
+			"ListRegionOverride":                                 testAccTransitGateway_List_regionOverride,
 		},
 		"MulticastDomain": {
 			acctest.CtBasic:      testAccTransitGatewayMulticastDomain_basic,
@@ -187,6 +191,10 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"transitGatewayDefaultRouteTableAssociation":                       testAccTransitGatewayVPCAttachment_transitGatewayDefaultRouteTableAssociation,
 			"transitGatewayDefaultRouteTableAssociationAndPropagationDisabled": testAccTransitGatewayVPCAttachment_transitGatewayDefaultRouteTableAssociationAndPropagationDisabled,
 			"transitGatewayDefaultRouteTablePropagation":                       testAccTransitGatewayVPCAttachment_transitGatewayDefaultRouteTablePropagation,
+			"Identity":            testAccTransitGatewayVPCAttachment_identity,
+			"ListBasic":           testAccTransitGatewayVPCAttachment_List_basic,
+			"ListIncludeResource": testAccTransitGatewayVPCAttachment_List_includeResource,
+			"ListRegionOverride":  testAccTransitGatewayVPCAttachment_List_regionOverride,
 		},
 		"VPCAttachmentAccepter": {
 			acctest.CtBasic: testAccTransitGatewayVPCAttachmentAccepter_basic,
@@ -906,6 +914,10 @@ func testAccCheckTransitGatewayExists(ctx context.Context, t *testing.T, n strin
 	}
 }
 
+func testAccTransitGateway_identity(t *testing.T, _ tfsync.Semaphore) {
+	testAccTransitGatewayTransitGateway_identitySerial(t)
+}
+
 func testAccCheckTransitGatewayDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
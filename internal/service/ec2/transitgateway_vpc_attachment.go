@@ -7,6 +7,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -28,6 +29,10 @@ import (
 // @SDKResource("aws_ec2_transit_gateway_vpc_attachment", name="Transit Gateway VPC Attachment")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.TransitGatewayVpcAttachment")
+// @Testing(preIdentityVersion="v6.53.0")
+// @Testing(serialize=true)
 func resourceTransitGatewayVPCAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayVPCAttachmentCreate,
@@ -35,10 +40,6 @@ func resourceTransitGatewayVPCAttachment() *schema.Resource {
 		UpdateWithoutTimeout: resourceTransitGatewayVPCAttachmentUpdate,
 		DeleteWithoutTimeout: resourceTransitGatewayVPCAttachmentDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"appliance_mode_support": {
@@ -189,11 +190,22 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceTransitGatewayVPCAttachmentFlatten(ctx, c, transitGatewayVPCAttachment, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayVPCAttachmentFlatten(ctx context.Context, awsClient *conns.AWSClient, transitGatewayVPCAttachment *awstypes.TransitGatewayVpcAttachment, d *schema.ResourceData) error {
+	conn := awsClient.EC2Client(ctx)
+	id := aws.ToString(transitGatewayVPCAttachment.TransitGatewayAttachmentId)
+
 	transitGatewayID := aws.ToString(transitGatewayVPCAttachment.TransitGatewayId)
 	transitGateway, err := findTransitGatewayByID(ctx, conn, transitGatewayID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+		return fmt.Errorf("reading EC2 Transit Gateway (%s): %w", transitGatewayID, err)
 	}
 
 	// We cannot read Transit Gateway Route Tables for Resource Access Manager shared Transit Gateways.
@@ -202,24 +214,24 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 
 	if aws.ToString(transitGateway.OwnerId) == aws.ToString(transitGatewayVPCAttachment.VpcOwnerId) {
 		if transitGatewayRouteTableID := aws.ToString(transitGateway.Options.AssociationDefaultRouteTableId); transitGatewayRouteTableID != "" {
-			_, err := findTransitGatewayRouteTableAssociationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, d.Id())
+			_, err := findTransitGatewayRouteTableAssociationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, id)
 
 			if retry.NotFound(err) {
 				transitGatewayDefaultRouteTableAssociation = false
 			} else if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Association (%s): %s", transitGatewayRouteTableAssociationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
+				return fmt.Errorf("reading EC2 Transit Gateway Route Table Association (%s): %w", transitGatewayRouteTableAssociationCreateResourceID(transitGatewayRouteTableID, id), err)
 			}
 		} else {
 			transitGatewayDefaultRouteTableAssociation = false
 		}
 
 		if transitGatewayRouteTableID := aws.ToString(transitGateway.Options.PropagationDefaultRouteTableId); transitGatewayRouteTableID != "" {
-			_, err := findTransitGatewayRouteTablePropagationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, d.Id())
+			_, err := findTransitGatewayRouteTablePropagationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, id)
 
 			if retry.NotFound(err) {
 				transitGatewayDefaultRouteTablePropagation = false
 			} else if err != nil {
-				return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table Propagation (%s): %s", transitGatewayRouteTablePropagationCreateResourceID(transitGatewayRouteTableID, d.Id()), err)
+				return fmt.Errorf("reading EC2 Transit Gateway Route Table Propagation (%s): %w", transitGatewayRouteTablePropagationCreateResourceID(transitGatewayRouteTableID, id), err)
 			}
 		} else {
 			transitGatewayDefaultRouteTablePropagation = false
@@ -228,7 +240,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 
 	d.Set("appliance_mode_support", transitGatewayVPCAttachment.Options.ApplianceModeSupport)
 	vpcOwnerID := aws.ToString(transitGatewayVPCAttachment.VpcOwnerId)
-	d.Set(names.AttrARN, transitGatewayAttachmentARN(ctx, c, vpcOwnerID, d.Id()))
+	d.Set(names.AttrARN, transitGatewayAttachmentARN(ctx, awsClient, vpcOwnerID, id))
 	d.Set("dns_support", transitGatewayVPCAttachment.Options.DnsSupport)
 	d.Set("ipv6_support", transitGatewayVPCAttachment.Options.Ipv6Support)
 	d.Set("security_group_referencing_support", transitGatewayVPCAttachment.Options.SecurityGroupReferencingSupport)
@@ -241,7 +253,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 
 	setTagsOut(ctx, transitGatewayVPCAttachment.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceTransitGatewayVPCAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
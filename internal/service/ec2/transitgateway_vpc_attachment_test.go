@@ -696,6 +696,10 @@ func testAccCheckTransitGatewayVPCAttachmentExists(ctx context.Context, t *testi
 	}
 }
 
+func testAccTransitGatewayVPCAttachment_identity(t *testing.T, _ tfsync.Semaphore) {
+	testAccTransitGatewayTransitGatewayVPCAttachment_identitySerial(t)
+}
+
 func testAccCheckTransitGatewayVPCAttachmentDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
@@ -27,6 +27,9 @@ import (
 // @SDKResource("aws_vpc_dhcp_options", name="DHCP Options")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.DhcpOptions")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceVPCDHCPOptions() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCDHCPOptionsCreate,
@@ -34,10 +37,6 @@ func resourceVPCDHCPOptions() *schema.Resource {
 		UpdateWithoutTimeout: resourceVPCDHCPOptionsUpdate,
 		DeleteWithoutTimeout: resourceVPCDHCPOptionsDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		// Keep in sync with aws_default_vpc_dhcp_options' schema.
 		// See notes in vpc_default_vpc_dhcp_options.go.
 		SchemaFunc: func() map[string]*schema.Schema {
@@ -152,19 +151,25 @@ func resourceVPCDHCPOptionsRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EC2 DHCP Options (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceVPCDHCPOptionsFlatten(ctx, c, opts, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 DHCP Options (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceVPCDHCPOptionsFlatten(ctx context.Context, awsClient *conns.AWSClient, opts *awstypes.DhcpOptions, d *schema.ResourceData) error {
 	ownerID := aws.ToString(opts.OwnerId)
-	d.Set(names.AttrARN, dhcpOptionsARN(ctx, c, ownerID, d.Id()))
+	d.Set(names.AttrARN, dhcpOptionsARN(ctx, awsClient, ownerID, aws.ToString(opts.DhcpOptionsId)))
 	d.Set(names.AttrOwnerID, ownerID)
 
-	err = optionsMap.dhcpConfigurationsToResourceData(opts.DhcpConfigurations, d)
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 DHCP Options (%s): %s", d.Id(), err)
+	if err := optionsMap.dhcpConfigurationsToResourceData(opts.DhcpConfigurations, d); err != nil {
+		return err
 	}
 
 	setTagsOut(ctx, opts.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceVPCDHCPOptionsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -7,6 +7,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -31,6 +32,9 @@ import (
 // @SDKResource("aws_vpc_endpoint_service", name="VPC Endpoint Service")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.ServiceConfiguration")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceVPCEndpointService() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCEndpointServiceCreate,
@@ -38,10 +42,6 @@ func resourceVPCEndpointService() *schema.Resource {
 		UpdateWithoutTimeout: resourceVPCEndpointServiceUpdate,
 		DeleteWithoutTimeout: resourceVPCEndpointServiceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"acceptance_required": {
@@ -234,8 +234,19 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceVPCEndpointServiceFlatten(ctx, c, svcCfg, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceVPCEndpointServiceFlatten(ctx context.Context, awsClient *conns.AWSClient, svcCfg *awstypes.ServiceConfiguration, d *schema.ResourceData) error {
+	conn := awsClient.EC2Client(ctx)
+	id := aws.ToString(svcCfg.ServiceId)
+
 	d.Set("acceptance_required", svcCfg.AcceptanceRequired)
-	d.Set(names.AttrARN, vpcEndpointServiceARN(ctx, c, d.Id()))
+	d.Set(names.AttrARN, vpcEndpointServiceARN(ctx, awsClient, id))
 	d.Set(names.AttrAvailabilityZones, svcCfg.AvailabilityZones)
 	d.Set("base_endpoint_dns_names", svcCfg.BaseEndpointDnsNames)
 	d.Set("gateway_load_balancer_arns", svcCfg.GatewayLoadBalancerArns)
@@ -245,7 +256,7 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 	// The EC2 API can return a XML structure with no elements.
 	if tfMap := flattenPrivateDNSNameConfiguration(svcCfg.PrivateDnsNameConfiguration); len(tfMap) > 0 {
 		if err := d.Set("private_dns_name_configuration", []any{tfMap}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting private_dns_name_configuration: %s", err)
+			return fmt.Errorf("setting private_dns_name_configuration: %w", err)
 		}
 	} else {
 		d.Set("private_dns_name_configuration", nil)
@@ -262,15 +273,15 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 
 	setTagsOut(ctx, svcCfg.Tags)
 
-	allowedPrincipals, err := findVPCEndpointServicePermissionsByServiceID(ctx, conn, d.Id())
+	allowedPrincipals, err := findVPCEndpointServicePermissionsByServiceID(ctx, conn, id)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s) permissions: %s", d.Id(), err)
+		return fmt.Errorf("reading permissions: %w", err)
 	}
 
 	d.Set("allowed_principals", flattenAllowedPrincipals(allowedPrincipals))
 
-	return diags
+	return nil
 }
 
 func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -7,6 +7,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
@@ -31,6 +32,9 @@ import (
 // @SDKResource("aws_flow_log", name="Flow Log")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.FlowLog")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceFlowLog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLogFlowCreate,
@@ -38,10 +42,6 @@ func resourceFlowLog() *schema.Resource {
 		UpdateWithoutTimeout: resourceLogFlowUpdate,
 		DeleteWithoutTimeout: resourceLogFlowDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				names.AttrARN: {
@@ -289,11 +289,19 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading Flow Log (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, flowLogARN(ctx, c, d.Id()))
+	if err := resourceFlowLogFlatten(ctx, c, fl, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Flow Log (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceFlowLogFlatten(ctx context.Context, awsClient *conns.AWSClient, fl *awstypes.FlowLog, d *schema.ResourceData) error {
+	d.Set(names.AttrARN, flowLogARN(ctx, awsClient, aws.ToString(fl.FlowLogId)))
 	d.Set("deliver_cross_account_role", fl.DeliverCrossAccountRole)
 	if fl.DestinationOptions != nil {
 		if err := d.Set("destination_options", []any{flattenDestinationOptionsResponse(fl.DestinationOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting destination_options: %s", err)
+			return fmt.Errorf("setting destination_options: %w", err)
 		}
 	} else {
 		d.Set("destination_options", nil)
@@ -301,7 +309,7 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set(names.AttrIAMRoleARN, fl.DeliverLogsPermissionArn)
 	if fl.LogDestinationType == awstypes.LogDestinationTypeCloudWatchLogs && fl.LogDestination == nil {
 		// Legacy usage of LogGroupName. Either importing or migrating from old version of the proivder
-		d.Set("log_destination", cloudwatchLogGroupARNFromName(ctx, c, aws.ToString(fl.LogGroupName)))
+		d.Set("log_destination", cloudwatchLogGroupARNFromName(ctx, awsClient, aws.ToString(fl.LogGroupName)))
 	} else {
 		d.Set("log_destination", fl.LogDestination)
 	}
@@ -330,7 +338,7 @@ func resourceLogFlowRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	setTagsOut(ctx, fl.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceLogFlowUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
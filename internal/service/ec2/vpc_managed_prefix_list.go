@@ -29,6 +29,9 @@ import (
 // @SDKResource("aws_ec2_managed_prefix_list", name="Managed Prefix List")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.ManagedPrefixList")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceManagedPrefixList() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceManagedPrefixListCreate,
@@ -36,10 +39,6 @@ func resourceManagedPrefixList() *schema.Resource {
 		UpdateWithoutTimeout: resourceManagedPrefixListUpdate,
 		DeleteWithoutTimeout: resourceManagedPrefixListDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ComputedIf(names.AttrVersion, func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
 				return diff.HasChange("entry")
@@ -152,16 +151,26 @@ func resourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
 	}
 
-	prefixListEntries, err := findManagedPrefixListEntriesByID(ctx, conn, d.Id())
+	if err := resourceManagedPrefixListFlatten(ctx, conn, pl, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceManagedPrefixListFlatten(ctx context.Context, conn *ec2.Client, pl *awstypes.ManagedPrefixList, d *schema.ResourceData) error {
+	id := aws.ToString(pl.PrefixListId)
+
+	prefixListEntries, err := findManagedPrefixListEntriesByID(ctx, conn, id)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Managed Prefix List (%s) Entries: %s", d.Id(), err)
+		return fmt.Errorf("reading entries: %w", err)
 	}
 
 	d.Set("address_family", pl.AddressFamily)
 	d.Set(names.AttrARN, pl.PrefixListArn)
 	if err := d.Set("entry", flattenPrefixListEntries(prefixListEntries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting entry: %s", err)
+		return fmt.Errorf("setting entry: %w", err)
 	}
 	d.Set("max_entries", pl.MaxEntries)
 	d.Set(names.AttrName, pl.PrefixListName)
@@ -170,7 +179,7 @@ func resourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData,
 
 	setTagsOut(ctx, pl.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceManagedPrefixListUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_ec2_managed_prefix_list")
+func newManagedPrefixListResourceAsListResource() inttypes.ListResourceForSDK {
+	l := managedPrefixListListResource{}
+	l.SetResourceSchema(resourceManagedPrefixList())
+
+	return &l
+}
+
+var _ list.ListResource = &managedPrefixListListResource{}
+
+type managedPrefixListListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type managedPrefixListListResourceModel struct {
+	framework.WithRegionModel
+	PrefixListIDs fwtypes.ListValueOf[types.String] `tfsdk:"prefix_list_ids"`
+	Filters       customListFilters                 `tfsdk:"filter"`
+}
+
+func (l *managedPrefixListListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"prefix_list_ids": listschema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *managedPrefixListListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query managedPrefixListListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeManagedPrefixListsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listManagedPrefixLists(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// AWS-managed prefix lists cannot be managed with this resource type.
+			if aws.ToString(item.OwnerId) == "AWS" || item.State == awstypes.PrefixListStateDeleteComplete {
+				continue
+			}
+
+			id := aws.ToString(item.PrefixListId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				if err := resourceManagedPrefixListFlatten(ctx, conn, &item, rd); err != nil {
+					tflog.Error(ctx, "Reading EC2 Managed Prefix List", map[string]any{
+						"error": err.Error(),
+					})
+					continue
+				}
+			}
+
+			if v := aws.ToString(item.PrefixListName); v != "" {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v, id)
+			} else {
+				result.DisplayName = id
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listManagedPrefixLists(ctx context.Context, conn *ec2.Client, input *ec2.DescribeManagedPrefixListsInput) iter.Seq2[awstypes.ManagedPrefixList, error] {
+	return func(yield func(awstypes.ManagedPrefixList, error) bool) {
+		pages := ec2.NewDescribeManagedPrefixListsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.ManagedPrefixList{}, fmt.Errorf("listing EC2 Managed Prefix Lists: %w", err))
+				return
+			}
+
+			for _, item := range page.PrefixLists {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
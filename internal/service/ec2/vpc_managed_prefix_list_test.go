@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -427,10 +428,16 @@ func testAccCheckManagedPrefixListDestroy(ctx context.Context, t *testing.T) res
 }
 
 func testAccManagedPrefixListExists(ctx context.Context, t *testing.T, resourceName string) resource.TestCheckFunc {
+	var v awstypes.ManagedPrefixList
+
+	return testAccCheckManagedPrefixListExists(ctx, t, resourceName, &v)
+}
+
+func testAccCheckManagedPrefixListExists(ctx context.Context, t *testing.T, n string, v *awstypes.ManagedPrefixList) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[resourceName]
+		rs, ok := s.RootModule().Resources[n]
 		if !ok {
-			return fmt.Errorf("Not found: %s", resourceName)
+			return fmt.Errorf("Not found: %s", n)
 		}
 
 		if rs.Primary.ID == "" {
@@ -439,9 +446,15 @@ func testAccManagedPrefixListExists(ctx context.Context, t *testing.T, resourceN
 
 		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
 
-		_, err := tfec2.FindManagedPrefixListByID(ctx, conn, rs.Primary.ID)
+		output, err := tfec2.FindManagedPrefixListByID(ctx, conn, rs.Primary.ID)
 
-		return err
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
 	}
 }
 
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -34,6 +35,10 @@ import (
 // @SDKResource("aws_network_acl", name="Network ACL")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.NetworkAcl")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceNetworkACL() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkACLCreate,
@@ -43,6 +48,10 @@ func resourceNetworkACL() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				if err := importer.Import(ctx, d, meta); err != nil {
+					return nil, err
+				}
+
 				conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 				nacl, err := findNetworkACLByID(ctx, conn, d.Id())
@@ -212,8 +221,16 @@ func resourceNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta an
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Network ACL (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceNetworkACLFlatten(ctx, c, nacl, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network ACL (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceNetworkACLFlatten(ctx context.Context, awsClient *conns.AWSClient, nacl *awstypes.NetworkAcl, d *schema.ResourceData) error {
 	ownerID := aws.ToString(nacl.OwnerId)
-	d.Set(names.AttrARN, networkACLARN(ctx, c, ownerID, d.Id()))
+	d.Set(names.AttrARN, networkACLARN(ctx, awsClient, ownerID, aws.ToString(nacl.NetworkAclId)))
 	d.Set(names.AttrOwnerID, ownerID)
 
 	var subnetIDs []string
@@ -240,15 +257,15 @@ func resourceNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 	if err := d.Set("egress", flattenNetworkACLEntries(egressEntries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+		return fmt.Errorf("setting egress: %w", err)
 	}
 	if err := d.Set("ingress", flattenNetworkACLEntries(ingressEntries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+		return fmt.Errorf("setting ingress: %w", err)
 	}
 
 	setTagsOut(ctx, nacl.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceNetworkACLUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -37,6 +37,10 @@ import (
 // @SDKResource("aws_network_interface", name="Network Interface")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.NetworkInterface")
+// @Testing(preIdentityVersion="v6.53.0")
+// @Testing(name="ENI")
 func resourceNetworkInterface() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceCreate,
@@ -44,10 +48,6 @@ func resourceNetworkInterface() *schema.Resource {
 		UpdateWithoutTimeout: resourceNetworkInterfaceUpdate,
 		DeleteWithoutTimeout: resourceNetworkInterfaceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				names.AttrARN: {
@@ -578,18 +578,26 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceNetworkInterfaceFlatten(ctx, c, eni, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceNetworkInterfaceFlatten(ctx context.Context, awsClient *conns.AWSClient, eni *awstypes.NetworkInterface, d *schema.ResourceData) error {
 	ownerID := aws.ToString(eni.OwnerId)
-	d.Set(names.AttrARN, networkInterfaceARN(ctx, c, ownerID, d.Id()))
+	d.Set(names.AttrARN, networkInterfaceARN(ctx, awsClient, ownerID, aws.ToString(eni.NetworkInterfaceId)))
 	if eni.Attachment != nil {
 		if err := d.Set("attachment", []any{flattenNetworkInterfaceAttachment(eni.Attachment)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting attachment: %s", err)
+			return fmt.Errorf("setting attachment: %w", err)
 		}
 	} else {
 		d.Set("attachment", nil)
 	}
 	if eni.Attachment != nil && eni.Attachment.EnaSrdSpecification != nil && aws.ToBool(eni.Attachment.EnaSrdSpecification.EnaSrdEnabled) {
 		if err := d.Set("ena_srd_specification", []any{flattenAttachmentEnaSrdSpecification(eni.Attachment.EnaSrdSpecification)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting ena_srd_specification: %s", err)
+			return fmt.Errorf("setting ena_srd_specification: %w", err)
 		}
 	} else {
 		d.Set("ena_srd_specification", nil)
@@ -597,23 +605,23 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set(names.AttrDescription, eni.Description)
 	d.Set("interface_type", eni.InterfaceType)
 	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
+		return fmt.Errorf("setting ipv4_prefixes: %w", err)
 	}
 	d.Set("ipv4_prefix_count", len(eni.Ipv4Prefixes))
 	d.Set("ipv6_address_count", len(eni.Ipv6Addresses))
 	if len(eni.Ipv6Addresses) > 0 {
 		if err := d.Set("enable_primary_ipv6", eni.Ipv6Addresses[0].IsPrimaryIpv6); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting enable_primary_ipv6: %s", err)
+			return fmt.Errorf("setting enable_primary_ipv6: %w", err)
 		}
 	}
 	if err := d.Set("ipv6_address_list", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6 address list: %s", err)
+		return fmt.Errorf("setting ipv6 address list: %w", err)
 	}
 	if err := d.Set("ipv6_addresses", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6_addresses: %s", err)
+		return fmt.Errorf("setting ipv6_addresses: %w", err)
 	}
 	if err := d.Set("ipv6_prefixes", flattenIPv6PrefixSpecifications(eni.Ipv6Prefixes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6_prefixes: %s", err)
+		return fmt.Errorf("setting ipv6_prefixes: %w", err)
 	}
 	d.Set("ipv6_prefix_count", len(eni.Ipv6Prefixes))
 	d.Set("mac_address", eni.MacAddress)
@@ -622,21 +630,21 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("private_dns_name", eni.PrivateDnsName)
 	d.Set("private_ip", eni.PrivateIpAddress)
 	if err := d.Set("private_ips", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting private_ips: %s", err)
+		return fmt.Errorf("setting private_ips: %w", err)
 	}
 	d.Set("private_ips_count", len(eni.PrivateIpAddresses)-1)
 	if err := d.Set("private_ip_list", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting private_ip_list: %s", err)
+		return fmt.Errorf("setting private_ip_list: %w", err)
 	}
 	if err := d.Set(names.AttrSecurityGroups, flattenGroupIdentifiers(eni.Groups)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting security_groups: %s", err)
+		return fmt.Errorf("setting security_groups: %w", err)
 	}
 	d.Set("source_dest_check", eni.SourceDestCheck)
 	d.Set(names.AttrSubnetID, eni.SubnetId)
 
 	setTagsOut(ctx, eni.TagSet)
 
-	return diags
+	return nil
 }
 
 func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
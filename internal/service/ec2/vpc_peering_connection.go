@@ -29,16 +29,15 @@ import (
 // @SDKResource("aws_vpc_peering_connection", name="VPC Peering Connection")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.VpcPeeringConnection")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCPeeringConnectionCreate,
 		ReadWithoutTimeout:   resourceVPCPeeringConnectionRead,
 		UpdateWithoutTimeout: resourceVPCPeeringConnectionUpdate,
 		DeleteWithoutTimeout: resourceVPCPeeringConnectionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
@@ -172,10 +171,18 @@ func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceVPCPeeringConnectionFlatten(ctx, meta.(*conns.AWSClient), vpcPeeringConnection, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceVPCPeeringConnectionFlatten(ctx context.Context, awsClient *conns.AWSClient, vpcPeeringConnection *awstypes.VpcPeeringConnection, d *schema.ResourceData) error {
 	d.Set("accept_status", vpcPeeringConnection.Status.Code)
 	d.Set("peer_region", vpcPeeringConnection.AccepterVpcInfo.Region)
 
-	if accountID := meta.(*conns.AWSClient).AccountID(ctx); accountID == aws.ToString(vpcPeeringConnection.AccepterVpcInfo.OwnerId) && accountID != aws.ToString(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
+	if accountID := awsClient.AccountID(ctx); accountID == aws.ToString(vpcPeeringConnection.AccepterVpcInfo.OwnerId) && accountID != aws.ToString(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
 		// We're the accepter.
 		d.Set("peer_owner_id", vpcPeeringConnection.RequesterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", vpcPeeringConnection.RequesterVpcInfo.VpcId)
@@ -189,7 +196,7 @@ func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceDat
 
 	if vpcPeeringConnection.AccepterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("accepter", []any{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.AccepterVpcInfo.PeeringOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting accepter: %s", err)
+			return fmt.Errorf("setting accepter: %w", err)
 		}
 	} else {
 		d.Set("accepter", nil)
@@ -197,7 +204,7 @@ func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceDat
 
 	if vpcPeeringConnection.RequesterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("requester", []any{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.RequesterVpcInfo.PeeringOptions)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting requester: %s", err)
+			return fmt.Errorf("setting requester: %w", err)
 		}
 	} else {
 		d.Set("requester", nil)
@@ -205,7 +212,7 @@ func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceDat
 
 	setTagsOut(ctx, vpcPeeringConnection.Tags)
 
-	return diags
+	return nil
 }
 
 func resourceVPCPeeringConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
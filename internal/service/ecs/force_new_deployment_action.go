@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Non-standard statuses for the force new deployment action.
+	forceNewDeploymentStatusInProgress = "tfIN_PROGRESS"
+	forceNewDeploymentStatusSteady     = "tfSTEADY"
+	forceNewDeploymentStatusFailed     = "tfFAILED"
+	forceNewDeploymentStatusSuperseded = "tfSUPERSEDED"
+)
+
+// @Action(aws_ecs_force_new_deployment, name="Force New Deployment")
+func newForceNewDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &forceNewDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*forceNewDeploymentAction)(nil)
+)
+
+type forceNewDeploymentAction struct {
+	framework.ActionWithModel[forceNewDeploymentActionModel]
+}
+
+type forceNewDeploymentActionModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *forceNewDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service without changing its task definition, and waits for the service to reach a steady state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "The short name or ARN of the cluster that the service runs on",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name or ARN of the service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the service to reach a steady state (default: 1200)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *forceNewDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config forceNewDeploymentActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := fwflex.StringValueFromFramework(ctx, config.Cluster)
+	service := fwflex.StringValueFromFramework(ctx, config.Service)
+	timeout := fwactions.TimeoutOr(config.Timeout, 20*time.Minute)
+
+	tflog.Info(ctx, "Starting ECS force new deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting new deployment of ECS service %s in cluster %s...", service, cluster)
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Service",
+			fmt.Sprintf("Could not force a new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	deployment := findPrimaryTaskSet(output.Service.Deployments)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Deployment Not Found",
+			fmt.Sprintf("No primary deployment was returned for ECS service %s", service),
+		)
+		return
+	}
+	deploymentID := aws.ToString(deployment.Id)
+
+	cb(ctx, "Deployment %s started, waiting for ECS service %s to reach a steady state...", deploymentID, service)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("describing service: %w", err)
+		}
+		status, deployment := forceNewDeploymentStatus(output, deploymentID)
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: actionwait.Status(status), Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{forceNewDeploymentStatusSteady},
+		TransitionalStates: []actionwait.Status{forceNewDeploymentStatusInProgress},
+		FailureStates: []actionwait.Status{
+			forceNewDeploymentStatusFailed,
+			forceNewDeploymentStatusSuperseded,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*awstypes.Deployment); ok && v != nil {
+				cb(ctx, "Deployment %s: %d running, %d pending, %d desired (%s)...", deploymentID, v.RunningCount, v.PendingCount, v.DesiredCount, aws.ToString(v.RolloutStateReason))
+				return
+			}
+			cb(ctx, "Deployment %s is %s...", deploymentID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Service Steady State",
+				fmt.Sprintf("ECS service %s did not reach a steady state within %s: %s", service, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete: %s", deploymentID, service, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Service Steady State",
+				fmt.Sprintf("Error while waiting for ECS service %s to reach a steady state: %s", service, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "ECS service %s has reached a steady state with deployment %s", service, deploymentID)
+
+	tflog.Info(ctx, "ECS force new deployment action completed successfully", map[string]any{
+		"cluster":    cluster,
+		"service":    service,
+		"deployment": deploymentID,
+	})
+}
+
+// forceNewDeploymentStatus returns the status of the specified deployment of a service.
+// The deployment is steady once it is the only deployment and all its tasks are running.
+func forceNewDeploymentStatus(service *awstypes.Service, deploymentID string) (string, *awstypes.Deployment) {
+	for _, v := range service.Deployments {
+		if aws.ToString(v.Id) != deploymentID {
+			continue
+		}
+
+		if aws.ToString(v.Status) != taskSetStatusPrimary {
+			return forceNewDeploymentStatusSuperseded, &v
+		}
+
+		if v.RolloutState == awstypes.DeploymentRolloutStateFailed {
+			return forceNewDeploymentStatusFailed, &v
+		}
+
+		if len(service.Deployments) == 1 && v.RunningCount == v.DesiredCount && v.PendingCount == 0 {
+			return forceNewDeploymentStatusSteady, &v
+		}
+
+		return forceNewDeploymentStatusInProgress, &v
+	}
+
+	return forceNewDeploymentStatusSuperseded, nil
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSForceNewDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForceNewDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForceNewDeploymentActionDeployed(ctx, t, rName, "aws_ecs_service.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSForceNewDeploymentAction_nonExistentService(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccForceNewDeploymentActionConfig_nonExistentService(rName),
+				ExpectError: regexache.MustCompile(`ServiceNotFoundException`),
+			},
+		},
+	})
+}
+
+// testAccCheckForceNewDeploymentActionDeployed checks that the service has reached a steady state
+// with a deployment in addition to the one started when the service was created.
+func testAccCheckForceNewDeploymentActionDeployed(ctx context.Context, t *testing.T, clusterName, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ECSClient(ctx)
+
+		output, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], clusterName)
+		if err != nil {
+			return err
+		}
+
+		if n := len(output.Deployments); n != 1 {
+			return fmt.Errorf("ECS Service (%s) has %d active deployments, want 1", rs.Primary.ID, n)
+		}
+
+		input := ecs.ListServiceDeploymentsInput{
+			Cluster: aws.String(clusterName),
+			Service: output.ServiceName,
+		}
+		deployments, err := conn.ListServiceDeployments(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(deployments.ServiceDeployments); n < 2 {
+			return fmt.Errorf("ECS Service (%s) has %d service deployments, want at least 2", rs.Primary.ID, n)
+		}
+
+		return nil
+	}
+}
+
+func testAccForceNewDeploymentActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    cpu       = 128
+    memory    = 128
+    essential = true
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.arn
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+}
+
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_service.test]
+}
+`, rName)
+}
+
+func testAccForceNewDeploymentActionConfig_nonExistentService(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+action "aws_ecs_force_new_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_force_new_deployment.test]
+    }
+  }
+
+  depends_on = [aws_ecs_cluster.test]
+}
+`, rName)
+}
@@ -94,15 +94,17 @@ func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 		}
 
 		for _, v := range page.Clusters {
-			const (
-				timeout = 15 * time.Minute
-			)
-			err := updateClusterDeletionProtection(ctx, conn, v, false, timeout)
-
-			// There are EKS clusters that are listed (and are in the AWS Console) but can't be found.
-			// ¯\_(ツ)_/¯
-			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
-				continue
+			if !sweep.DryRun() {
+				const (
+					timeout = 15 * time.Minute
+				)
+				err := updateClusterDeletionProtection(ctx, conn, v, false, timeout)
+
+				// There are EKS clusters that are listed (and are in the AWS Console) but can't be found.
+				// ¯\_(ツ)_/¯
+				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+					continue
+				}
 			}
 
 			r := resourceCluster()
@@ -29,7 +29,7 @@ const (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -37,12 +37,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -51,7 +51,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -59,7 +59,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -68,7 +68,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_user", &resource.Sweeper{
 		Name: "aws_elasticache_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -76,12 +76,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
 		Name: "aws_elasticache_user_group",
 		F:    sweepUserGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
 		Name: "aws_elasticache_serverless_cache",
 		F:    sweepServerlessCaches,
 	})
@@ -89,6 +89,10 @@ func RegisterSweepers() {
 
 func sweepClusters(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_elasticache_cluster")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -138,6 +142,10 @@ func sweepClusters(region string) error {
 
 func sweepGlobalReplicationGroups(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_elasticache_global_replication_group")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
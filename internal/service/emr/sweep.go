@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -57,13 +57,15 @@ func sweepClusters(region string) error {
 		for _, v := range page.Clusters {
 			id := aws.ToString(v.Id)
 
-			_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
-				JobFlowIds:           []string{id},
-				TerminationProtected: aws.Bool(false),
-			})
+			if !sweep.DryRun() {
+				_, err := conn.SetTerminationProtection(ctx, &emr.SetTerminationProtectionInput{
+					JobFlowIds:           []string{id},
+					TerminationProtected: aws.Bool(false),
+				})
 
-			if err != nil {
-				log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				if err != nil {
+					log.Printf("[ERROR] unsetting EMR Cluster (%s) termination protection: %s", id, err)
+				}
 			}
 
 			r := resourceCluster()
@@ -16,13 +16,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
@@ -30,6 +30,10 @@ func RegisterSweepers() {
 
 func sweepDetectors(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_guardduty_detector")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -78,6 +82,10 @@ func sweepDetectors(region string) error {
 
 func sweepPublishingDestinations(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_guardduty_publishing_destination")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
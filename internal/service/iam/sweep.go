@@ -22,13 +22,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +43,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -56,7 +57,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -94,14 +95,14 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_service_specific_credential", sweepServiceSpecificCredentials)
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -116,6 +117,10 @@ func RegisterSweepers() {
 
 func sweepGroups(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_iam_group")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -261,7 +266,7 @@ func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep
 				d.Set(names.AttrRole, roles[0].RoleName)
 			}
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(instanceProfile.CreateDate))))
 		}
 	}
 
@@ -432,6 +437,10 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 
 func sweepRoles(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_iam_role")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -513,6 +522,10 @@ func sweepSAMLProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.S
 
 func sweepServerCertificates(region string) error {
 	ctx := sweep.Context(region)
+	if sweep.DryRun() {
+		return sweep.NotInventoried(ctx, "aws_iam_server_certificate")
+	}
+
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -583,7 +596,7 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 			d := r.Data(nil)
 			d.SetId(aws.ToString(role.Arn))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(role.CreateDate))))
 		}
 	}
 
@@ -630,7 +643,7 @@ func sweepUsers(region string) error {
 					// is missing something that affects sweeping, fix Delete. Most of the time,
 					// if something in Delete is causing sweep problems, it's also affecting
 					// some users when they destroy.
-					sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(user.CreateDate))))
 					break
 				}
 			}
@@ -263,6 +263,7 @@ func findLogAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.Client, in
 	return output, nil
 }
 
+// @AutoFlexSchema(input="cloudwatchlogs.CreateLogAnomalyDetectorInput", output="cloudwatchlogs.GetLogAnomalyDetectorOutput")
 type anomalyDetectorResourceModel struct {
 	framework.WithRegionModel
 	AnomalyDetectorARN    types.String                                     `tfsdk:"arn"`
@@ -416,6 +416,7 @@ func findDelivery(ctx context.Context, conn *cloudwatchlogs.Client, input *cloud
 	return output.Delivery, nil
 }
 
+// @AutoFlexSchema(input="cloudwatchlogs.CreateDeliveryInput", output="awstypes.Delivery")
 type deliveryResourceModel struct {
 	framework.WithRegionModel
 	ARN                     types.String                                                  `tfsdk:"arn"`
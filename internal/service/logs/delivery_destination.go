@@ -318,6 +318,7 @@ func requiresReplaceIfARNServiceChanges(ctx context.Context, request planmodifie
 	response.RequiresReplace = requiresReplace
 }
 
+// @AutoFlexSchema(input="cloudwatchlogs.PutDeliveryDestinationInput", output="awstypes.DeliveryDestination")
 type deliveryDestinationResourceModel struct {
 	framework.WithRegionModel
 	ARN                              types.String                                                           `tfsdk:"arn"`
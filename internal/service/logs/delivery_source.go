@@ -219,6 +219,7 @@ func findDeliverySource(ctx context.Context, conn *cloudwatchlogs.Client, input
 	return output.DeliverySource, nil
 }
 
+// @AutoFlexSchema(input="cloudwatchlogs.PutDeliverySourceInput", output="awstypes.DeliverySource")
 type deliverySourceResourceModel struct {
 	framework.WithRegionModel
 	ARN         types.String `tfsdk:"arn"`
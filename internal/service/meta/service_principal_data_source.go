@@ -9,13 +9,13 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/datasourceattribute"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -66,7 +66,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, request datasourc
 
 	regionID := region.ID()
 	serviceName := fwflex.StringValueFromFramework(ctx, data.ServiceName)
-	sourceServicePrincipal := servicePrincipalNameForPartition(serviceName, names.PartitionForRegion(regionID))
+	sourceServicePrincipal := inttypes.ServicePrincipalSuffixForPartition(serviceName, names.PartitionForRegion(regionID))
 
 	data.ID = fwflex.StringValueToFrameworkLegacy(ctx, serviceName+"."+regionID+"."+sourceServicePrincipal)
 	data.Name = fwflex.StringValueToFrameworkLegacy(ctx, serviceName+"."+sourceServicePrincipal)
@@ -83,37 +83,3 @@ type servicePrincipalDataSourceModel struct {
 	ServiceName types.String `tfsdk:"service_name"`
 	Suffix      types.String `tfsdk:"suffix"`
 }
-
-// SPN region unique taken from
-// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
-func servicePrincipalNameForPartition(service string, partition endpoints.Partition) string {
-	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
-		switch partitionID {
-		case endpoints.AwsIsoPartitionID:
-			switch service {
-			case "cloudhsm",
-				"config",
-				"logs",
-				"workspaces":
-				return partition.DNSSuffix()
-			}
-		case endpoints.AwsIsoBPartitionID:
-			switch service {
-			case "dms",
-				"logs":
-				return partition.DNSSuffix()
-			}
-		case endpoints.AwsCnPartitionID:
-			switch service {
-			case "codedeploy",
-				"elasticmapreduce",
-				"logs",
-				"ec2",
-				"s3":
-				return partition.DNSSuffix()
-			}
-		}
-	}
-
-	return "amazonaws.com"
-}
@@ -15,12 +15,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_mq_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_configuration", &resource.Sweeper{
 		Name: "aws_mq_configuration",
 		F:    sweepConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    sweepBrokers,
 	})
@@ -37,7 +37,7 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 		for _, v := range page.Graphs {
 			id := aws.ToString(v.Id)
 
-			if aws.ToBool(v.DeletionProtection) {
+			if aws.ToBool(v.DeletionProtection) && !sweep.DryRun() {
 				input := neptunegraph.UpdateGraphInput{
 					DeletionProtection: aws.Bool(false),
 					GraphIdentifier:    aws.String(id),
@@ -21,7 +21,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -42,7 +42,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 		Dependencies: []string{
@@ -50,17 +50,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_dx_gateway_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_dx_gateway_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_dx_gateway_attachment",
 		F:    sweepDirectConnectGatewayAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -68,17 +68,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -87,7 +87,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -95,7 +95,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -103,7 +103,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -111,7 +111,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
 		Name: "aws_pipes_pipe",
 		F:    sweepPipes,
 	})
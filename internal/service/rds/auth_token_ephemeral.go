@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// IAM database authentication tokens are valid for 15 minutes.
+	authTokenLifetime = 15 * time.Minute
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := fwflex.StringValueFromFramework(ctx, data.Hostname)
+	port := data.Port.ValueInt32()
+	username := fwflex.StringValueFromFramework(ctx, data.Username)
+	endpoint := net.JoinHostPort(hostname, strconv.Itoa(int(port)))
+
+	// The token is presigned locally with the provider's credentials; no API call is made.
+	issuedAt := time.Now()
+	token, err := auth.BuildAuthToken(ctx, endpoint, e.Meta().Region(ctx), username, e.Meta().CredentialsProvider(ctx))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("building RDS IAM authentication token for %s@%s: %w", username, endpoint, err))
+		return
+	}
+
+	data.ExpiresAt = types.StringValue(issuedAt.Add(authTokenLifetime).UTC().Format(time.RFC3339))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Hostname  types.String `tfsdk:"hostname"`
+	Port      types.Int32  `tfsdk:"port"`
+	Token     types.String `tfsdk:"token"`
+	Username  types.String `tfsdk:"username"`
+}
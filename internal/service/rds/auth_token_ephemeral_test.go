@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSAuthTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	hostname := "iam-auth.cluster-abcdefghijkl.example.com"
+	username := "iam_user"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig_basic(hostname, username),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("hostname"), knownvalue.StringExact(hostname)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrPort), knownvalue.Int32Exact(5432)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.StringRegexp(regexache.MustCompile(`^iam-auth\.cluster-abcdefghijkl\.example\.com:5432/\?Action=connect&DBUser=iam_user&X-Amz-`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrUsername), knownvalue.StringExact(username)),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthTokenEphemeralResourceConfig_basic(hostname, username string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_rds_auth_token.test"),
+		fmt.Sprintf(`
+ephemeral "aws_rds_auth_token" "test" {
+  hostname = %[1]q
+  port     = 5432
+  username = %[2]q
+}
+`, hostname, username))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -45,7 +46,13 @@ const (
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_identifier")
+// @CustomImport
 // @Testing(tagsTest=false)
+// @Testing(idAttrDuplicates="cluster_identifier")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBCluster")
+// @Testing(importIgnore="master_password;skip_final_snapshot;delete_automated_backups")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -1454,6 +1461,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceClusterFlatten(ctx, conn, dbc, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceClusterFlatten(ctx context.Context, conn *rds.Client, dbc *types.DBCluster, d *schema.ResourceData) error {
 	d.Set(names.AttrAllocatedStorage, dbc.AllocatedStorage)
 	clusterARN := aws.ToString(dbc.DBClusterArn)
 	d.Set(names.AttrARN, clusterARN)
@@ -1520,7 +1535,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	// https://awscli.amazonaws.com/v2/documentation/api/latest/reference/rds/create-db-cluster.html#:~:text=for%20future%20use.-,MasterUserSecret,-%2D%3E%20(structure)
 	if dbc.MasterUserSecret != nil {
 		if err := d.Set("master_user_secret", []any{flattenManagedMasterUserSecret(dbc.MasterUserSecret)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting master_user_secret: %s", err)
+			return fmt.Errorf("setting master_user_secret: %w", err)
 		}
 	} else {
 		d.Set("master_user_secret", nil)
@@ -1539,14 +1554,14 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
 	if dbc.ScalingConfigurationInfo != nil {
 		if err := d.Set("scaling_configuration", []any{flattenScalingConfigurationInfo(dbc.ScalingConfigurationInfo)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting scaling_configuration: %s", err)
+			return fmt.Errorf("setting scaling_configuration: %w", err)
 		}
 	} else {
 		d.Set("scaling_configuration", nil)
 	}
 	if dbc.ServerlessV2ScalingConfiguration != nil {
 		if err := d.Set("serverlessv2_scaling_configuration", []any{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting serverlessv2_scaling_configuration: %s", err)
+			return fmt.Errorf("setting serverlessv2_scaling_configuration: %w", err)
 		}
 	} else {
 		d.Set("serverlessv2_scaling_configuration", nil)
@@ -1570,13 +1585,13 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 			// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
 			// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
 		} else {
-			return sdkdiag.AppendErrorf(diags, "reading RDS Global Cluster for RDS Cluster (%s): %s", d.Id(), err)
+			return fmt.Errorf("reading RDS Global Cluster for RDS Cluster (%s): %w", aws.ToString(dbc.DBClusterIdentifier), err)
 		}
 	}
 
 	setTagsOut(ctx, dbc.TagList)
 
-	return diags
+	return nil
 }
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
@@ -2018,7 +2033,11 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 	return diags
 }
 
-func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
 	// from any API call, so we need to default skip_final_snapshot to true so
 	// that final_snapshot_identifier is not required
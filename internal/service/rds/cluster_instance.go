@@ -32,7 +32,12 @@ import (
 
 // @SDKResource("aws_rds_cluster_instance", name="Cluster Instance")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("identifier")
 // @Testing(tagsTest=false)
+// @Testing(idAttrDuplicates="identifier")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBInstance")
+// @Testing(importIgnore="apply_immediately")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceClusterInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterInstanceCreate,
@@ -40,10 +45,6 @@ func resourceClusterInstance() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterInstanceUpdate,
 		DeleteWithoutTimeout: resourceClusterInstanceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(90 * time.Minute),
@@ -381,20 +382,29 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Instance (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceClusterInstanceFlatten(ctx, conn, db, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceClusterInstanceFlatten(ctx context.Context, conn *rds.Client, db *types.DBInstance, d *schema.ResourceData) error {
+	id := aws.ToString(db.DBInstanceIdentifier)
 	dbClusterID := aws.ToString(db.DBClusterIdentifier)
 
 	if dbClusterID == "" {
-		return sdkdiag.AppendErrorf(diags, "DBClusterIdentifier is missing from RDS Cluster Instance (%s). The aws_db_instance resource should be used for non-Aurora instances", d.Id())
+		return fmt.Errorf("DBClusterIdentifier is missing from RDS Cluster Instance (%s). The aws_db_instance resource should be used for non-Aurora instances", id)
 	}
 
 	dbc, err := findDBClusterByID(ctx, conn, dbClusterID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", dbClusterID, err)
+		return fmt.Errorf("reading RDS Cluster (%s): %w", dbClusterID, err)
 	}
 
 	for _, m := range dbc.DBClusterMembers {
-		if aws.ToString(m.DBInstanceIdentifier) == d.Id() {
+		if aws.ToString(m.DBInstanceIdentifier) == id {
 			if aws.ToBool(m.IsClusterWriter) {
 				d.Set("writer", true)
 			} else {
@@ -443,7 +453,7 @@ func resourceClusterInstanceRead(ctx context.Context, d *schema.ResourceData, me
 
 	setTagsOut(ctx, db.TagList)
 
-	return diags
+	return nil
 }
 
 func resourceClusterInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_rds_cluster_instance")
+func newClusterInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterInstanceListResource{}
+	l.SetResourceSchema(resourceClusterInstance())
+	return &l
+}
+
+var _ list.ListResource = &clusterInstanceListResource{}
+
+type clusterInstanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterInstanceListResourceModel struct {
+	framework.WithRegionModel
+	Filters listFilters         `tfsdk:"filter"`
+	Tags    fwtypes.MapOfString `tfsdk:"tags"`
+}
+
+func (l *clusterInstanceListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: listTagsAttribute(),
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *clusterInstanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query clusterInstanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBInstancesInput
+	if diags := fwflex.Expand(ctx, query.Filters, &input.Filters); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing RDS Cluster Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listClusterInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Instances which are not Aurora cluster members are managed with the resource type "aws_db_instance".
+			if aws.ToString(item.DBClusterIdentifier) == "" {
+				continue
+			}
+
+			switch aws.ToString(item.Engine) {
+			case instanceEngineDocDB, instanceEngineNeptune:
+				continue
+			}
+
+			if !listTagsMatch(ctx, query.Tags, item.TagList) {
+				continue
+			}
+
+			id := aws.ToString(item.DBInstanceIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrIdentifier), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrIdentifier, id)
+
+			if request.IncludeResource {
+				if err := resourceClusterInstanceFlatten(ctx, conn, &item, rd); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listClusterInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing RDS Cluster Instance resources: %w", err))
+				return
+			}
+
+			for _, item := range page.DBInstances {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_rds_cluster")
+func newClusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+	return &l
+}
+
+var _ list.ListResource = &clusterListResource{}
+
+type clusterListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+	Filters listFilters         `tfsdk:"filter"`
+	Tags    fwtypes.MapOfString `tfsdk:"tags"`
+}
+
+func (l *clusterListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: listTagsAttribute(),
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBClustersInput
+	if diags := fwflex.Expand(ctx, query.Filters, &input.Filters); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing RDS Clusters")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listClusters(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			switch aws.ToString(item.Engine) {
+			case clusterEngineDocDB, clusterEngineNeptune:
+				continue
+			}
+
+			if !listTagsMatch(ctx, query.Tags, item.TagList) {
+				continue
+			}
+
+			id := aws.ToString(item.DBClusterIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrClusterIdentifier), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrClusterIdentifier, id)
+
+			if request.IncludeResource {
+				if err := resourceClusterFlatten(ctx, conn, &item, rd); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				// As on import, neither skip_final_snapshot nor final_snapshot_identifier can be fetched from any API call.
+				rd.Set("skip_final_snapshot", true)
+				rd.Set("delete_automated_backups", true)
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClustersInput) iter.Seq2[awstypes.DBCluster, error] {
+	return func(yield func(awstypes.DBCluster, error) bool) {
+		pages := rds.NewDescribeDBClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBCluster{}, fmt.Errorf("listing RDS Cluster resources: %w", err))
+				return
+			}
+
+			for _, item := range page.DBClusters {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"time"
@@ -31,7 +32,11 @@ import (
 
 // @SDKResource("aws_rds_cluster_parameter_group", name="Cluster Parameter Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBClusterParameterGroup")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterParameterGroupCreate,
@@ -39,10 +44,6 @@ func resourceClusterParameterGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterParameterGroupUpdate,
 		DeleteWithoutTimeout: resourceClusterParameterGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				names.AttrARN: {
@@ -148,6 +149,14 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Parameter Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceClusterParameterGroupFlatten(ctx, conn, dbClusterParameterGroup, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceClusterParameterGroupFlatten(ctx context.Context, conn *rds.Client, dbClusterParameterGroup *types.DBClusterParameterGroup, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, dbClusterParameterGroup.DBClusterParameterGroupArn)
 	d.Set(names.AttrDescription, dbClusterParameterGroup.Description)
 	d.Set(names.AttrFamily, dbClusterParameterGroup.DBParameterGroupFamily)
@@ -156,14 +165,14 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 
 	// Only include user customized parameters as there's hundreds of system/default ones.
 	input := rds.DescribeDBClusterParametersInput{
-		DBClusterParameterGroupName: aws.String(d.Id()),
+		DBClusterParameterGroupName: dbClusterParameterGroup.DBClusterParameterGroupName,
 		Source:                      aws.String(parameterSourceUser),
 	}
 
 	parameters, err := findDBClusterParameters(ctx, conn, &input, tfslices.PredicateTrue[*types.Parameter]())
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Parameter Group (%s) user parameters: %s", d.Id(), err)
+		return fmt.Errorf("reading RDS Cluster Parameter Group (%s) user parameters: %w", aws.ToString(dbClusterParameterGroup.DBClusterParameterGroupName), err)
 	}
 
 	// Add only system parameters that are set in the config.
@@ -175,7 +184,7 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 	configParameters := expandParameters(s.List())
 
 	input = rds.DescribeDBClusterParametersInput{
-		DBClusterParameterGroupName: aws.String(d.Id()),
+		DBClusterParameterGroupName: dbClusterParameterGroup.DBClusterParameterGroupName,
 		Source:                      aws.String(parameterSourceSystem),
 	}
 
@@ -186,16 +195,16 @@ func resourceClusterParameterGroupRead(ctx context.Context, d *schema.ResourceDa
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Parameter Group (%s) system parameters: %s", d.Id(), err)
+		return fmt.Errorf("reading RDS Cluster Parameter Group (%s) system parameters: %w", aws.ToString(dbClusterParameterGroup.DBClusterParameterGroupName), err)
 	}
 
 	parameters = append(parameters, systemParameters...)
 
 	if err := d.Set(names.AttrParameter, flattenParameters(parameters)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+		return fmt.Errorf("setting parameter: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func resourceClusterParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
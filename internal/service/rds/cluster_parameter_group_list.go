@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_rds_cluster_parameter_group")
+func newClusterParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterParameterGroupListResource{}
+	l.SetResourceSchema(resourceClusterParameterGroup())
+	return &l
+}
+
+var _ list.ListResource = &clusterParameterGroupListResource{}
+
+type clusterParameterGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterParameterGroupListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *clusterParameterGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query clusterParameterGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing RDS Cluster Parameter Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := &rds.DescribeDBClusterParameterGroupsInput{}
+		for item, err := range listClusterParameterGroups(ctx, conn, input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Default parameter groups cannot be modified.
+			if strings.HasPrefix(aws.ToString(item.DBClusterParameterGroupName), "default.") {
+				continue
+			}
+
+			id := aws.ToString(item.DBClusterParameterGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrName, id)
+
+			if request.IncludeResource {
+				if err := resourceClusterParameterGroupFlatten(ctx, conn, &item, rd); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listClusterParameterGroups(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterParameterGroupsInput) iter.Seq2[awstypes.DBClusterParameterGroup, error] {
+	return func(yield func(awstypes.DBClusterParameterGroup, error) bool) {
+		pages := rds.NewDescribeDBClusterParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBClusterParameterGroup{}, fmt.Errorf("listing RDS Cluster Parameter Group resources: %w", err))
+				return
+			}
+
+			for _, item := range page.DBClusterParameterGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
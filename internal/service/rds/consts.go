@@ -22,6 +22,7 @@ const (
 	clusterStatusConfiguringIAMDatabaseAuth    = "configuring-iam-database-auth"
 	clusterStatusCreating                      = "creating"
 	clusterStatusDeleting                      = "deleting"
+	clusterStatusFailingOver                   = "failing-over"
 	clusterStatusMigrating                     = "migrating"
 	clusterStatusModifying                     = "modifying"
 	clusterStatusPreparingDataMigration        = "preparing-data-migration"
@@ -41,6 +42,7 @@ const (
 	clusterSnapshotStatusAvailable = "available"
 	clusterSnapshotStatusCreating  = "creating"
 	clusterSnapshotStatusCopying   = "copying"
+	clusterSnapshotStatusFailed    = "failed"
 )
 
 const (
@@ -141,6 +143,7 @@ const (
 const (
 	dbSnapshotAvailable = "available"
 	dbSnapshotCreating  = "creating"
+	dbSnapshotFailed    = "failed"
 )
 
 const (
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotActionModel]
+}
+
+type createDBClusterSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String        `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String        `tfsdk:"db_cluster_snapshot_identifier"`
+	Tags                        fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout                     types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB cluster and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier for the DB cluster snapshot. If not provided, an identifier will be generated from the DB cluster identifier",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z-]*$`), "must begin with a letter and contain only alphanumeric characters and hyphens"),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				Description: "Tags to assign to the DB cluster snapshot",
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	snapshotID := fwflex.StringValueFromFramework(ctx, config.DBClusterSnapshotIdentifier)
+	if snapshotID == "" {
+		snapshotID = fmt.Sprintf("%s-%s", clusterID, create.UniqueId(ctx))
+	}
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS create DB cluster snapshot action", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+		names.AttrTimeout:                timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB cluster %s...", snapshotID, clusterID)
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                        svcTags(tftags.New(ctx, config.Tags).IgnoreAWS()),
+	}
+
+	// The cluster may briefly be in a state, e.g. backing-up, that does not allow a snapshot to be taken.
+	var err error
+	for l := backoff.NewLoop(timeout); l.Continue(ctx); {
+		_, err = conn.CreateDBClusterSnapshot(ctx, &input)
+
+		if errs.IsAErrorMessageContains[*awstypes.InvalidDBClusterStateFault](err, "backing-up") {
+			cb(ctx, "RDS DB cluster %s is being backed up, retrying...", clusterID)
+			continue
+		}
+
+		break
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Cluster Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB cluster %s: %s", snapshotID, clusterID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Snapshot %s started, waiting for it to become available...", snapshotID)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBClusterSnapshot], error) {
+		output, err := findDBClusterSnapshotByID(ctx, conn, snapshotID)
+		if retry.NotFound(err) {
+			// Eventual consistency.
+			return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{Status: clusterSnapshotStatusCreating}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{}, fmt.Errorf("describing DB cluster snapshot: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{Status: actionwait.Status(aws.ToString(output.Status)), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBClusterSnapshot]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{clusterSnapshotStatusAvailable},
+		TransitionalStates: []actionwait.Status{clusterSnapshotStatusCreating},
+		FailureStates:      []actionwait.Status{clusterSnapshotStatusFailed},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*awstypes.DBClusterSnapshot); ok && v != nil {
+				cb(ctx, "Snapshot %s is %s (%d%% complete)...", snapshotID, fr.Status, aws.ToInt32(v.PercentProgress))
+				return
+			}
+			cb(ctx, "Snapshot %s is %s...", snapshotID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB cluster %s did not become available within %s: %s", snapshotID, clusterID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"DB Cluster Snapshot Failed",
+				fmt.Sprintf("Snapshot %s of RDS DB cluster %s failed: %s", snapshotID, clusterID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB cluster %s: %s", snapshotID, clusterID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Snapshot %s of RDS DB cluster %s is available", snapshotID, clusterID)
+
+	tflog.Info(ctx, "RDS create DB cluster snapshot action completed successfully", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+	})
+}
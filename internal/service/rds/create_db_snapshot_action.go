@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotActionModel]
+}
+
+type createDBSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String        `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String        `tfsdk:"db_snapshot_identifier"`
+	Tags                 fwtypes.MapOfString `tfsdk:"tags"`
+	Timeout              types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB instance and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier for the DB snapshot. If not provided, an identifier will be generated from the DB instance identifier",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z-]*$`), "must begin with a letter and contain only alphanumeric characters and hyphens"),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				Description: "Tags to assign to the DB snapshot",
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, config.DBInstanceIdentifier)
+	snapshotID := fwflex.StringValueFromFramework(ctx, config.DBSnapshotIdentifier)
+	if snapshotID == "" {
+		snapshotID = fmt.Sprintf("%s-%s", instanceID, create.UniqueId(ctx))
+	}
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating snapshot %s of RDS DB instance %s...", snapshotID, instanceID)
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                 svcTags(tftags.New(ctx, config.Tags).IgnoreAWS()),
+	}
+
+	// The instance may briefly be in a state, e.g. backing-up, that does not allow a snapshot to be taken.
+	var err error
+	for l := backoff.NewLoop(timeout); l.Continue(ctx); {
+		_, err = conn.CreateDBSnapshot(ctx, &input)
+
+		if errs.IsAErrorMessageContains[*awstypes.InvalidDBInstanceStateFault](err, "backing-up") {
+			cb(ctx, "RDS DB instance %s is being backed up, retrying...", instanceID)
+			continue
+		}
+
+		break
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Snapshot %s started, waiting for it to become available...", snapshotID)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBSnapshot], error) {
+		output, err := findDBSnapshotByID(ctx, conn, snapshotID)
+		if retry.NotFound(err) {
+			// Eventual consistency.
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: dbSnapshotCreating}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{}, fmt.Errorf("describing DB snapshot: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: actionwait.Status(aws.ToString(output.Status)), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBSnapshot]{
+		Timeout:            timeout,
+		Interval:           actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{dbSnapshotAvailable},
+		TransitionalStates: []actionwait.Status{dbSnapshotCreating},
+		FailureStates:      []actionwait.Status{dbSnapshotFailed},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*awstypes.DBSnapshot); ok && v != nil {
+				cb(ctx, "Snapshot %s is %s (%d%% complete)...", snapshotID, fr.Status, aws.ToInt32(v.PercentProgress))
+				return
+			}
+			cb(ctx, "Snapshot %s is %s...", snapshotID, fr.Status)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Snapshot",
+				fmt.Sprintf("Snapshot %s of RDS DB instance %s did not become available within %s: %s", snapshotID, instanceID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"DB Snapshot Failed",
+				fmt.Sprintf("Snapshot %s of RDS DB instance %s failed: %s", snapshotID, instanceID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Snapshot %s of RDS DB instance %s is available", snapshotID, instanceID)
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+	})
+}
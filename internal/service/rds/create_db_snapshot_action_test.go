@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCreateDBSnapshotAction_nonExistentInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName),
+				ExpectError: regexache.MustCompile(`DBInstanceNotFound`),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateDBSnapshotActionSnapshotAvailable checks that the action's snapshot is available and then deletes it,
+// as snapshots created by the action are not managed by Terraform.
+func testAccCheckCreateDBSnapshotActionSnapshotAvailable(ctx context.Context, t *testing.T, snapshotID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, snapshotID)
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Snapshot (%s) status = %q, want %q", snapshotID, got, want)
+		}
+
+		input := rds.DeleteDBSnapshotInput{
+			DBSnapshotIdentifier: aws.String(snapshotID),
+		}
+		_, err = conn.DeleteDBSnapshot(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSnapshotConfig_base(rName),
+		fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+
+  depends_on = [aws_db_instance.test]
+}
+`, rName))
+}
+
+func testAccCreateDBSnapshotActionConfig_nonExistentInstance(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName)
+}
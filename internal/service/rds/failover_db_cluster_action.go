@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// failoverDBClusterPollInterval defines polling cadence for the failover DB cluster action.
+	failoverDBClusterPollInterval = 10 * time.Second
+
+	// Non-standard status for the failover DB cluster action: the failover has been requested
+	// but the writer has not yet changed.
+	clusterStatusFailoverPending = "tf-failover-pending"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an Aurora DB cluster, promoting a reader to be the writer, and waits for the cluster to become available with the new writer.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the reader DB instance to promote to the writer. If not provided, Aurora chooses the reader",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 900)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := fwflex.StringValueFromFramework(ctx, config.DBClusterIdentifier)
+	targetID := fwflex.StringValueFromFramework(ctx, config.TargetDBInstanceIdentifier)
+	timeout := fwactions.TimeoutOr(config.Timeout, 15*time.Minute)
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         clusterID,
+		"target_db_instance_identifier": targetID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting failover of RDS DB cluster %s...", clusterID)
+
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Cluster Not Found",
+			fmt.Sprintf("RDS DB cluster %s was not found", clusterID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	if status := aws.ToString(cluster.Status); status != clusterStatusAvailable {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("RDS DB cluster %s is in state '%s' and cannot be failed over. The cluster must be in the 'available' state.", clusterID, status),
+		)
+		return
+	}
+
+	previousWriter := clusterWriter(cluster)
+	if targetID != "" && targetID == previousWriter {
+		cb(ctx, "DB instance %s is already the writer of RDS DB cluster %s", targetID, clusterID)
+		return
+	}
+
+	cb(ctx, "Current writer of RDS DB cluster %s is %s, requesting failover...", clusterID, previousWriter)
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	if targetID != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetID)
+	}
+
+	_, err = conn.FailoverDBCluster(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	cb(ctx, "Failover requested for RDS DB cluster %s, waiting for a new writer...", clusterID)
+
+	// The cluster may report 'available' for a short time after the failover is requested,
+	// so success also requires the writer to have changed.
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[string], error) {
+		cluster, err := findDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return actionwait.FetchResult[string]{}, fmt.Errorf("describing DB cluster: %w", err)
+		}
+		status, writer := aws.ToString(cluster.Status), clusterWriter(cluster)
+		if status == clusterStatusAvailable && (writer == previousWriter || writer == "" || (targetID != "" && writer != targetID)) {
+			status = clusterStatusFailoverPending
+		}
+		return actionwait.FetchResult[string]{Status: actionwait.Status(status), Value: writer}, nil
+	}, actionwait.Options[string]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(failoverDBClusterPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterStatusAvailable},
+		TransitionalStates: []actionwait.Status{
+			clusterStatusFailingOver,
+			clusterStatusFailoverPending,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "RDS DB cluster %s is currently in state '%s' with writer %v, continuing to wait...", clusterID, fr.Status, fr.Value)
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Failover",
+				fmt.Sprintf("RDS DB cluster %s did not complete failover within %s: %s", clusterID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Cluster State",
+				fmt.Sprintf("RDS DB cluster %s entered unexpected state during failover: %s", clusterID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Failover",
+				fmt.Sprintf("Error while waiting for RDS DB cluster %s to fail over: %s", clusterID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "RDS DB cluster %s has failed over from %s to %s", clusterID, previousWriter, result.Value)
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": clusterID,
+		"previous_writer":       previousWriter,
+		"writer":                result.Value,
+	})
+}
+
+// clusterWriter returns the identifier of the DB cluster's writer instance, if any.
+func clusterWriter(cluster *awstypes.DBCluster) string {
+	for _, v := range cluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFailoverDBClusterActionWriterChanged(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSFailoverDBClusterAction_nonExistentCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFailoverDBClusterActionConfig_nonExistentCluster(rName),
+				ExpectError: regexache.MustCompile(`DB Cluster Not Found`),
+			},
+		},
+	})
+}
+
+// testAccCheckFailoverDBClusterActionWriterChanged checks that the cluster's writer is no longer
+// the cluster instance recorded as the writer in state when the instances were created.
+func testAccCheckFailoverDBClusterActionWriterChanged(ctx context.Context, t *testing.T, clusterID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var previousWriter string
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type == "aws_rds_cluster_instance" && rs.Primary.Attributes["writer"] == "true" {
+				previousWriter = rs.Primary.ID
+			}
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if aws.ToBool(v.IsClusterWriter) {
+				if writer := aws.ToString(v.DBInstanceIdentifier); writer == previousWriter {
+					return fmt.Errorf("RDS Cluster (%s) writer is still %s", clusterID, writer)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster (%s) has no writer", clusterID)
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccClusterInstanceConfig_base(rName, "aurora-mysql"),
+		fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier = aws_rds_cluster.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`, rName))
+}
+
+func testAccFailoverDBClusterActionConfig_nonExistentCluster(rName string) string {
+	return fmt.Sprintf(`
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName)
+}
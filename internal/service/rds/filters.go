@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// listFiltersBlock returns the schema for the "filter" block of list resources,
+// which is passed through to the "Filters" parameter of the RDS Describe operations.
+func listFiltersBlock(ctx context.Context) listschema.ListNestedBlock {
+	return listschema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[listFilterModel](ctx),
+		NestedObject: listschema.NestedBlockObject{
+			Attributes: map[string]listschema.Attribute{
+				names.AttrName: listschema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: listschema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+type listFilterModel struct {
+	Name   types.String         `tfsdk:"name"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+type listFilters = fwtypes.ListNestedObjectValueOf[listFilterModel]
+
+// listTagsAttribute returns the schema for the "tags" attribute of list resources.
+// The RDS Describe operations do not support filtering by tag, so results are filtered client-side.
+func listTagsAttribute() listschema.MapAttribute {
+	return listschema.MapAttribute{
+		CustomType:  fwtypes.MapOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// listTagsMatch returns whether the resource tags include all the tags set in a list resource's configuration.
+func listTagsMatch(ctx context.Context, want fwtypes.MapOfString, tags []awstypes.Tag) bool {
+	if want.IsNull() || want.IsUnknown() {
+		return true
+	}
+
+	return keyValueTags(ctx, tags).ContainsAll(tftags.New(ctx, want.MapValue))
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -55,8 +56,11 @@ import (
 
 // @SDKResource("aws_db_instance", name="DB Instance")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @CustomImport
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBInstance")
 // @Testing(importIgnore="apply_immediately;password")
+// @Testing(preIdentityVersion="v6.53.0")
 func resourceInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceCreate,
@@ -1963,6 +1967,14 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	d.SetId(aws.ToString(v.DbiResourceId))
+	if err := resourceInstanceFlatten(ctx, meta.(*conns.AWSClient), v, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceInstanceFlatten(ctx context.Context, awsClient *conns.AWSClient, v *types.DBInstance, d *schema.ResourceData) error {
 	d.Set(names.AttrAllocatedStorage, v.AllocatedStorage)
 	d.Set(names.AttrARN, v.DBInstanceArn)
 	d.Set(names.AttrAutoMinorVersionUpgrade, v.AutoMinorVersionUpgrade)
@@ -2027,7 +2039,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 	// https://awscli.amazonaws.com/v2/documentation/api/latest/reference/rds/create-db-cluster.html#:~:text=for%20future%20use.-,MasterUserSecret,-%2D%3E%20(structure)
 	if v.MasterUserSecret != nil {
 		if err := d.Set("master_user_secret", []any{flattenManagedMasterUserSecret(v.MasterUserSecret)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting master_user_secret: %s", err)
+			return fmt.Errorf("setting master_user_secret: %w", err)
 		}
 	} else {
 		d.Set("master_user_secret", nil)
@@ -2063,7 +2075,6 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 			original := original.(string)
 			if arn.IsARN(original) {
 				if !arn.IsARN(sourceDBIdentifier) {
-					awsClient := meta.(*conns.AWSClient)
 					sourceDBIdentifier = newDBInstanceARNString(ctx, awsClient, sourceDBIdentifier)
 				}
 			}
@@ -2094,7 +2105,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	if v.ListenerEndpoint != nil {
 		if err := d.Set("listener_endpoint", []any{flattenEndpoint(v.ListenerEndpoint)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting listener_endpoint: %s", err)
+			return fmt.Errorf("setting listener_endpoint: %w", err)
 		}
 	} else {
 		d.Set("listener_endpoint", nil)
@@ -2104,7 +2115,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	setTagsOut(ctx, v.TagList)
 
-	return diags
+	return nil
 }
 
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -2428,7 +2439,11 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta an
 	return diags
 }
 
-func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+func resourceInstanceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
 	// Neither skip_final_snapshot nor final_snapshot_identifier can be fetched
 	// from any API call, so we need to default skip_final_snapshot to true so
 	// that final_snapshot_identifier is not required.
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_db_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := instanceListResource{}
+	l.SetResourceSchema(resourceInstance())
+	return &l
+}
+
+var _ list.ListResource = &instanceListResource{}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type instanceListResourceModel struct {
+	framework.WithRegionModel
+	Filters listFilters         `tfsdk:"filter"`
+	Tags    fwtypes.MapOfString `tfsdk:"tags"`
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: listTagsAttribute(),
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: listFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query instanceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeDBInstancesInput
+	if diags := fwflex.Expand(ctx, query.Filters, &input.Filters); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing RDS DB Instances")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Aurora cluster members are managed with the resource type "aws_rds_cluster_instance".
+			if aws.ToString(item.DBClusterIdentifier) != "" {
+				continue
+			}
+
+			switch aws.ToString(item.Engine) {
+			case instanceEngineDocDB, instanceEngineNeptune:
+				continue
+			}
+
+			if !listTagsMatch(ctx, query.Tags, item.TagList) {
+				continue
+			}
+
+			id := aws.ToString(item.DbiResourceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			if request.IncludeResource {
+				if err := resourceInstanceFlatten(ctx, awsClient, &item, rd); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				// As on import, neither skip_final_snapshot nor final_snapshot_identifier can be fetched from any API call.
+				rd.Set("skip_final_snapshot", true)
+				rd.Set("delete_automated_backups", true)
+			}
+
+			result.DisplayName = aws.ToString(item.DBInstanceIdentifier)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing RDS DB Instance resources: %w", err))
+				return
+			}
+
+			for _, item := range page.DBInstances {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"slices"
 	"strings"
@@ -31,7 +32,12 @@ import (
 
 // @SDKResource("aws_db_option_group", name="DB Option Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.OptionGroup")
+// @Testing(preIdentityVersion="v6.53.0")
+// @Testing(name="Option Group")
 func resourceOptionGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOptionGroupCreate,
@@ -39,10 +45,6 @@ func resourceOptionGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceOptionGroupUpdate,
 		DeleteWithoutTimeout: resourceOptionGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(15 * time.Minute),
 		},
@@ -183,19 +185,27 @@ func resourceOptionGroupRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading RDS DB Option Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceOptionGroupFlatten(option, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
+
+	return diags
+}
+
+func resourceOptionGroupFlatten(option *types.OptionGroup, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, option.OptionGroupArn)
 	d.Set("engine_name", option.EngineName)
 	d.Set("major_engine_version", option.MajorEngineVersion)
 	d.Set(names.AttrName, option.OptionGroupName)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(option.OptionGroupName)))
 	if err := d.Set("option", flattenOptions(option.Options, expandOptionConfigurations(d.Get("option").(*schema.Set).List()))); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting option: %s", err)
+		return fmt.Errorf("setting option: %w", err)
 	}
 	d.Set("option_group_description", option.OptionGroupDescription)
-	// Support in-place update of non-refreshable attribute.
-	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
 
-	return diags
+	return nil
 }
 
 func resourceOptionGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_db_option_group")
+func newOptionGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := optionGroupListResource{}
+	l.SetResourceSchema(resourceOptionGroup())
+	return &l
+}
+
+var _ list.ListResource = &optionGroupListResource{}
+
+type optionGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type optionGroupListResourceModel struct {
+	framework.WithRegionModel
+	EngineName         types.String `tfsdk:"engine_name"`
+	MajorEngineVersion types.String `tfsdk:"major_engine_version"`
+}
+
+func (l *optionGroupListResource) ListResourceConfigSchema(ctx context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"engine_name": listschema.StringAttribute{
+				Optional: true,
+			},
+			"major_engine_version": listschema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (l *optionGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	var query optionGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input rds.DescribeOptionGroupsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing RDS DB Option Groups")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range listOptionGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Default option groups cannot be modified.
+			if strings.HasPrefix(aws.ToString(item.OptionGroupName), "default:") {
+				continue
+			}
+
+			id := aws.ToString(item.OptionGroupName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrName), id)
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrName, id)
+
+			if request.IncludeResource {
+				if err := resourceOptionGroupFlatten(&item, rd); err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, rd, &result)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listOptionGroups(ctx context.Context, conn *rds.Client, input *rds.DescribeOptionGroupsInput) iter.Seq2[awstypes.OptionGroup, error] {
+	return func(yield func(awstypes.OptionGroup, error) bool) {
+		pages := rds.NewDescribeOptionGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.OptionGroup{}, fmt.Errorf("listing RDS DB Option Group resources: %w", err))
+				return
+			}
+
+			for _, item := range page.OptionGroupsList {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"iter"
 	"log"
 	"slices"
@@ -34,7 +35,12 @@ import (
 
 // @SDKResource("aws_db_parameter_group", name="DB Parameter Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
 // @Testing(tagsTest=false)
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/rds/types;types.DBParameterGroup")
+// @Testing(preIdentityVersion="v6.53.0")
+// @Testing(name="Parameter Group")
 func resourceParameterGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceParameterGroupCreate,
@@ -42,10 +48,6 @@ func resourceParameterGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceParameterGroupUpdate,
 		DeleteWithoutTimeout: resourceParameterGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				names.AttrARN: {
@@ -156,6 +158,17 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading RDS DB Parameter Group (%s): %s", d.Id(), err)
 	}
 
+	if err := resourceParameterGroupFlatten(ctx, conn, dbParameterGroup, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Support in-place update of non-refreshable attribute.
+	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
+
+	return diags
+}
+
+func resourceParameterGroupFlatten(ctx context.Context, conn *rds.Client, dbParameterGroup *types.DBParameterGroup, d *schema.ResourceData) error {
 	d.Set(names.AttrARN, dbParameterGroup.DBParameterGroupArn)
 	d.Set(names.AttrDescription, dbParameterGroup.Description)
 	d.Set(names.AttrFamily, dbParameterGroup.DBParameterGroupFamily)
@@ -163,7 +176,7 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(dbParameterGroup.DBParameterGroupName)))
 
 	input := rds.DescribeDBParametersInput{
-		DBParameterGroupName: aws.String(d.Id()),
+		DBParameterGroupName: dbParameterGroup.DBParameterGroupName,
 	}
 
 	configParams := d.Get(names.AttrParameter).(*schema.Set)
@@ -183,7 +196,7 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 	parameters, err := findDBParameters(ctx, conn, &input, tfslices.PredicateTrue[*types.Parameter]())
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading RDS DB Parameter Group (%s) parameters: %s", d.Id(), err)
+		return fmt.Errorf("reading RDS DB Parameter Group (%s) parameters: %w", aws.ToString(dbParameterGroup.DBParameterGroupName), err)
 	}
 
 	var userParams []types.Parameter
@@ -230,13 +243,10 @@ func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if err := d.Set(names.AttrParameter, flattenParameters(userParams)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
+		return fmt.Errorf("setting parameter: %w", err)
 	}
 
-	// Support in-place update of non-refreshable attribute.
-	d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
-
-	return diags
+	return nil
 }
 
 func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -106,7 +107,7 @@ func sweepClusterSnapshots(ctx context.Context, client *conns.AWSClient) ([]swee
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.SnapshotCreateTime)), inventory.WithTags(keyValueTags(ctx, v.TagList).Map())))
 		}
 	}
 
@@ -156,7 +157,7 @@ func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 				}
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.ClusterCreateTime)), inventory.WithTags(keyValueTags(ctx, v.TagList).Map())))
 		}
 	}
 
@@ -257,7 +258,7 @@ func sweepInstances(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 			d.Set(names.AttrIdentifier, v.DBInstanceIdentifier)
 			d.Set("skip_final_snapshot", true)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.InstanceCreateTime)), inventory.WithTags(keyValueTags(ctx, v.TagList).Map())))
 		}
 	}
 
@@ -378,7 +379,7 @@ func sweepSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(v.SnapshotCreateTime)), inventory.WithTags(keyValueTags(ctx, v.TagList).Map())))
 		}
 	}
 
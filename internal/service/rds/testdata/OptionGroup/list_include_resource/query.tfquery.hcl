@@ -0,0 +1,8 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_db_option_group" "test" {
+  provider = aws
+
+  include_resource = true
+}
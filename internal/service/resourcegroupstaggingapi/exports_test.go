@@ -0,0 +1,9 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+// Exports for use in tests only.
+var (
+	TagDriftOf = tagDriftOf
+)
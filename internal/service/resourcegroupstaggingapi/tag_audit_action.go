@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_resourcegroupstaggingapi_tag_audit, name="Tag Audit")
+func newTagAuditAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &tagAuditAction{}, nil
+}
+
+var (
+	_ action.Action = (*tagAuditAction)(nil)
+)
+
+type tagAuditAction struct {
+	framework.ActionWithModel[tagAuditActionModel]
+}
+
+type tagAuditActionModel struct {
+	framework.WithRegionModel
+	FailOnDrift         types.Bool                                      `tfsdk:"fail_on_drift"`
+	ResourceARNs        fwtypes.ListOfString                            `tfsdk:"resource_arns"`
+	ResourceTypeFilters fwtypes.ListOfString                            `tfsdk:"resource_type_filters"`
+	TagFilters          fwtypes.ListNestedObjectValueOf[tagFilterModel] `tfsdk:"tag_filter"`
+	Tags                fwtypes.MapOfString                             `tfsdk:"tags"`
+}
+
+type tagFilterModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *tagAuditAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Audits the live tags of resources against the tags the provider would compute from default_tags. Mismatches are reported as progress events without refreshing any resource.",
+		Attributes: map[string]schema.Attribute{
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Whether the action fails if any audited resource has tag drift. Defaults to false",
+				Optional:    true,
+			},
+			"resource_arns": schema.ListAttribute{
+				Description: "ARNs of the resources to audit. Cannot be combined with resource_type_filters or tag_filter",
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+					listvalidator.ConflictsWith(
+						path.MatchRoot("resource_type_filters"),
+						path.MatchRoot("tag_filter"),
+					),
+				},
+			},
+			"resource_type_filters": schema.ListAttribute{
+				Description: "Resource types to audit, in the format service[:resourceType], e.g. ec2:instance",
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				Description: "Additional tags expected on every audited resource. These are merged with the provider's default_tags, as for tags_all",
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_filter": schema.ListNestedBlock{
+				Description: "Limits the audit to resources with matching tags",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Tag key to match",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							Description: "Tag values to match. If omitted, any value matches",
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *tagAuditAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config tagAuditActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := a.Meta().IgnoreTagsConfig(ctx)
+
+	// Compute the expected tags as for tags_all.
+	expected := a.Meta().DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, config.Tags)).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	if len(expected) == 0 {
+		resp.Diagnostics.AddError(
+			"No Expected Tags",
+			"Neither the provider's default_tags nor the action's tags argument define any tags to audit against",
+		)
+		return
+	}
+
+	input := resourcegroupstaggingapi.GetResourcesInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.ResourceARNList = fwflex.ExpandFrameworkStringValueList(ctx, config.ResourceARNs)
+
+	tflog.Info(ctx, "Starting Resource Groups Tagging API tag audit action", map[string]any{
+		"expected_tag_keys": len(expected),
+		"resource_arns":     len(input.ResourceARNList),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Auditing resource tags against %d expected tag(s)...", len(expected))
+
+	var audited, drifted int
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Get Resources",
+				fmt.Sprintf("Could not read Resource Groups Tagging API resources: %s", err),
+			)
+			return
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			audited++
+
+			if drift := tagDriftOf(ctx, expected, v, ignoreTagsConfig); drift != "" {
+				drifted++
+				cb(ctx, "%s", drift)
+			}
+		}
+
+		cb(ctx, "Audited %d resource(s), %d with tag drift so far...", audited, drifted)
+	}
+
+	cb(ctx, "Tag audit complete: %d resource(s) audited, %d with tag drift", audited, drifted)
+
+	tflog.Info(ctx, "Resource Groups Tagging API tag audit action completed", map[string]any{
+		"audited": audited,
+		"drifted": drifted,
+	})
+
+	if drifted > 0 && fwflex.BoolValueFromFramework(ctx, config.FailOnDrift) {
+		resp.Diagnostics.AddError(
+			"Tag Drift Detected",
+			fmt.Sprintf("%d of %d audited resource(s) have tags that differ from the expected tags", drifted, audited),
+		)
+	}
+}
+
+// tagDriftOf returns a description of the differences between the expected tags and a resource's live tags.
+// An empty string is returned if every expected tag is present with the expected value.
+// Live tags that are not expected are not considered drift as they may be set on the resource itself.
+func tagDriftOf(ctx context.Context, expected tftags.KeyValueTags, mapping awstypes.ResourceTagMapping, ignoreTagsConfig *tftags.IgnoreConfig) string {
+	live := keyValueTags(ctx, mapping.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	var missing, changed []string
+	for k, v := range expected.Difference(live) {
+		if live.KeyExists(k) {
+			changed = append(changed, fmt.Sprintf("%s (expected %q, got %q)", k, v.ValueString(), aws.ToString(live.KeyValue(k))))
+		} else {
+			missing = append(missing, k)
+		}
+	}
+
+	if len(missing) == 0 && len(changed) == 0 {
+		return ""
+	}
+
+	slices.Sort(missing)
+	slices.Sort(changed)
+
+	var parts []string
+	if len(missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(missing, ", "))
+	}
+	if len(changed) > 0 {
+		parts = append(parts, "changed "+strings.Join(changed, ", "))
+	}
+
+	return fmt.Sprintf("%s: %s", aws.ToString(mapping.ResourceARN), strings.Join(parts, "; "))
+}
@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTagDriftOf(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const arn = "arn:aws:sns:us-west-2:123456789012:example" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		expected     map[string]string
+		live         map[string]string
+		ignoreConfig *tftags.IgnoreConfig
+		want         string
+	}{
+		"no drift": {
+			expected: map[string]string{"Owner": "platform"},
+			live:     map[string]string{"Owner": "platform"},
+		},
+		"extra live tags": {
+			expected: map[string]string{"Owner": "platform"},
+			live:     map[string]string{"Owner": "platform", "Name": "example", "aws:cloudformation:stack-name": "stack"},
+		},
+		"missing": {
+			expected: map[string]string{"Owner": "platform", "CostCenter": "1234"},
+			live:     map[string]string{"Owner": "platform"},
+			want:     arn + ": missing CostCenter",
+		},
+		"changed": {
+			expected: map[string]string{"Owner": "platform"},
+			live:     map[string]string{"Owner": "data"},
+			want:     arn + `: changed Owner (expected "platform", got "data")`,
+		},
+		"missing and changed": {
+			expected: map[string]string{"Owner": "platform", "CostCenter": "1234", "Env": "prod"},
+			live:     map[string]string{"Owner": "data"},
+			want:     arn + `: missing CostCenter, Env; changed Owner (expected "platform", got "data")`,
+		},
+		"ignored live tag": {
+			expected: map[string]string{"Owner": "platform"},
+			live:     map[string]string{"Owner": "platform", "Managed": "by-hand"},
+			ignoreConfig: &tftags.IgnoreConfig{
+				Keys: tftags.New(ctx, []string{"Managed"}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var live []awstypes.Tag
+			for k, v := range testCase.live {
+				live = append(live, awstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			mapping := awstypes.ResourceTagMapping{
+				ResourceARN: aws.String(arn),
+				Tags:        live,
+			}
+
+			got := tfresourcegroupstaggingapi.TagDriftOf(ctx, tftags.New(ctx, testCase.expected), mapping, testCase.ignoreConfig)
+
+			if got != testCase.want {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPITagAuditAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagAuditActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagAuditAction_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagAuditActionConfig_drift(rName),
+				ExpectError: regexache.MustCompile(`Tag Drift Detected`),
+			},
+		},
+	})
+}
+
+func testAccTagAuditActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigDefaultTags_Tags1(acctest.CtKey1, acctest.CtValue1),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccTagAuditActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTagAuditActionConfig_base(rName),
+		`
+action "aws_resourcegroupstaggingapi_tag_audit" "test" {
+  config {
+    resource_arns = [aws_sns_topic.test.arn]
+    fail_on_drift = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_resourcegroupstaggingapi_tag_audit.test]
+    }
+  }
+
+  depends_on = [aws_sns_topic.test]
+}
+`)
+}
+
+func testAccTagAuditActionConfig_drift(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTagAuditActionConfig_base(rName),
+		`
+action "aws_resourcegroupstaggingapi_tag_audit" "test" {
+  config {
+    resource_arns = [aws_sns_topic.test.arn]
+    fail_on_drift = true
+
+    tags = {
+      key2 = "value2"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_resourcegroupstaggingapi_tag_audit.test]
+    }
+  }
+
+  depends_on = [aws_sns_topic.test]
+}
+`)
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+	// SigV4 presigned URLs are valid for at most 7 days.
+	presignedURLMaxExpiresIn = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("version_id")),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(presignedURLMaxExpiresIn/time.Second)),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := fwflex.StringValueFromFramework(ctx, data.Bucket)
+	conn := e.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	method := http.MethodGet
+	if v := fwflex.StringValueFromFramework(ctx, data.Method); v != "" {
+		method = v
+	}
+
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	if method == http.MethodPut && !data.VersionID.IsNull() {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("version_id can only be set when method is %s", http.MethodGet))
+		return
+	}
+	if method == http.MethodGet && !data.ContentType.IsNull() {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("content_type can only be set when method is %s", http.MethodPut))
+		return
+	}
+
+	presigner := s3.NewPresignClient(conn, s3.WithPresignExpires(expiresIn))
+	issuedAt := time.Now()
+
+	var output *v4.PresignedHTTPRequest
+	var err error
+	switch method {
+	case http.MethodPut:
+		input := s3.PutObjectInput{
+			Bucket:      fwflex.StringFromFramework(ctx, data.Bucket),
+			ContentType: fwflex.StringFromFramework(ctx, data.ContentType),
+			Key:         fwflex.StringFromFramework(ctx, data.Key),
+		}
+		output, err = presigner.PresignPutObject(ctx, &input)
+	default:
+		input := s3.GetObjectInput{
+			Bucket:    fwflex.StringFromFramework(ctx, data.Bucket),
+			Key:       fwflex.StringFromFramework(ctx, data.Key),
+			VersionId: fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+		output, err = presigner.PresignGetObject(ctx, &input)
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("presigning S3 %s URL for s3://%s/%s: %w", method, bucket, data.Key.ValueString(), err))
+		return
+	}
+
+	signedHeaders := make(map[string]string, len(output.SignedHeader))
+	for k, v := range output.SignedHeader {
+		signedHeaders[k] = strings.Join(v, ",")
+	}
+
+	data.ExpiresAt = types.StringValue(issuedAt.Add(expiresIn).UTC().Format(time.RFC3339))
+	data.SignedHeaders = fwflex.FlattenFrameworkStringValueMapOfString(ctx, signedHeaders)
+	data.URL = types.StringValue(output.URL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket        types.String        `tfsdk:"bucket"`
+	ContentType   types.String        `tfsdk:"content_type"`
+	ExpiresAt     types.String        `tfsdk:"expires_at"`
+	ExpiresIn     types.Int64         `tfsdk:"expires_in"`
+	Key           types.String        `tfsdk:"key"`
+	Method        types.String        `tfsdk:"method"`
+	SignedHeaders fwtypes.MapOfString `tfsdk:"signed_headers"`
+	URL           types.String        `tfsdk:"url"`
+	VersionID     types.String        `tfsdk:"version_id"`
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3PresignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_headers"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`/artifacts/bootstrap\.sh\?.*X-Amz-Expires=900&`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3PresignedURLEphemeral_put(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralResourceConfig_put(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_headers").AtMapKey("Content-Type"), knownvalue.StringExact("application/json")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`/results/output\.json\?.*X-Amz-Expires=3600&`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccPresignedURLEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket = %[1]q
+  key    = "artifacts/bootstrap.sh"
+}
+`, rName))
+}
+
+func testAccPresignedURLEphemeralResourceConfig_put(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket       = %[1]q
+  key          = "results/output.json"
+  method       = "PUT"
+  content_type = "application/json"
+  expires_in   = 3600
+}
+`, rName))
+}
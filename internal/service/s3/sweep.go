@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -187,7 +188,7 @@ func sweepBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 			d := r.Data(nil)
 			d.SetId(aws.ToString(bucket.Name))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client, inventory.WithCreatedAt(aws.ToTime(bucket.CreationDate))))
 		}
 	}
 
@@ -243,7 +244,7 @@ func sweepDirectoryBuckets(ctx context.Context, client *conns.AWSClient) ([]swee
 
 			sweepResources = append(sweepResources, framework.NewSweepResource(newDirectoryBucketResource, client,
 				framework.NewAttribute(names.AttrID, aws.ToString(bucket.Name)),
-			))
+			).WithInventory(inventory.WithCreatedAt(aws.ToTime(bucket.CreationDate))))
 		}
 	}
 
@@ -5,19 +5,31 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -36,10 +48,12 @@ type startExecutionAction struct {
 
 type startExecutionActionModel struct {
 	framework.WithRegionModel
-	StateMachineArn types.String `tfsdk:"state_machine_arn"`
-	Input           types.String `tfsdk:"input"`
-	Name            types.String `tfsdk:"name"`
-	TraceHeader     types.String `tfsdk:"trace_header"`
+	StateMachineArn   types.String `tfsdk:"state_machine_arn"`
+	Input             types.String `tfsdk:"input"`
+	Name              types.String `tfsdk:"name"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	TraceHeader       types.String `tfsdk:"trace_header"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
 }
 
 func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
@@ -61,10 +75,22 @@ func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequ
 				Description: "Name of the execution. Must be unique within the account/region/state machine for 90 days. Auto-generated if not provided.",
 				Optional:    true,
 			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete when wait_for_completion is true. Defaults to 3600.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(31536000),
+				},
+			},
 			"trace_header": schema.StringAttribute{
 				Description: "AWS X-Ray trace header for distributed tracing.",
 				Optional:    true,
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the execution to reach a terminal status, reporting state transitions as progress. The action fails if the execution does not succeed. Not supported for Express state machines. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -90,6 +116,29 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 	})
 
 	cb := fwactions.NewSendProgressFunc(resp)
+
+	waitForCompletion := fwflex.BoolValueFromFramework(ctx, config.WaitForCompletion)
+
+	// Express executions cannot be described, so check before starting one that would never complete.
+	if waitForCompletion {
+		output, err := findStateMachineByARN(ctx, conn, unqualifiedStateMachineARN(stateMachineArn))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Describe Step Functions State Machine",
+				fmt.Sprintf("Could not describe state machine %s: %s", stateMachineArn, err),
+			)
+			return
+		}
+
+		if typ := output.Type; typ == awstypes.StateMachineTypeExpress {
+			resp.Diagnostics.AddError(
+				"Unsupported Step Functions State Machine Type",
+				fmt.Sprintf("wait_for_completion is not supported for state machine %s of type %s, whose executions cannot be described", stateMachineArn, typ),
+			)
+			return
+		}
+	}
+
 	cb(ctx, "Starting execution for state machine %s...", stateMachineArn)
 
 	startInput := &sfn.StartExecutionInput{
@@ -122,4 +171,178 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		"execution_arn":     executionArn,
 		"start_date":        output.StartDate,
 	})
+
+	if !waitForCompletion {
+		return
+	}
+
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	cb(ctx, "Waiting for execution %s to complete...", executionArn)
+
+	// Report state transitions from the execution history as they occur.
+	var lastEventID int64
+	reportHistory := func(ctx context.Context) {
+		events, err := findExecutionHistoryEvents(ctx, conn, executionArn, lastEventID)
+		if err != nil {
+			tflog.Warn(ctx, "Reading Step Functions execution history", map[string]any{
+				"execution_arn": executionArn,
+				"error":         err.Error(),
+			})
+			return
+		}
+
+		for _, event := range events {
+			lastEventID = event.Id
+
+			if v := event.StateEnteredEventDetails; v != nil {
+				cb(ctx, "Entered state %s", aws.ToString(v.Name))
+			} else if v := event.StateExitedEventDetails; v != nil {
+				cb(ctx, "Exited state %s", aws.ToString(v.Name))
+			}
+		}
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*sfn.DescribeExecutionOutput], error) {
+		output, err := findExecutionByARN(ctx, conn, executionArn)
+		if retry.NotFound(err) {
+			// Eventual consistency.
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{Status: actionwait.Status(awstypes.ExecutionStatusRunning)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{}, fmt.Errorf("describing execution: %w", err)
+		}
+		return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*sfn.DescribeExecutionOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: 10 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.ExecutionStatusSucceeded)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusRunning),
+			actionwait.Status(awstypes.ExecutionStatusPendingRedrive),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusFailed),
+			actionwait.Status(awstypes.ExecutionStatusTimedOut),
+			actionwait.Status(awstypes.ExecutionStatusAborted),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			reportHistory(ctx)
+		},
+	})
+
+	// Report any state transitions since the last poll, whether or not the execution succeeded.
+	if result.Value != nil {
+		reportHistory(ctx)
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Step Functions Execution",
+				fmt.Sprintf("Execution %s did not complete within %s: %s", executionArn, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := fmt.Sprintf("status %s", failureErr.Status)
+			if v := result.Value; v != nil {
+				if e := aws.ToString(v.Error); e != "" {
+					detail = fmt.Sprintf("%s: %s", detail, e)
+				}
+				if c := aws.ToString(v.Cause); c != "" {
+					detail = fmt.Sprintf("%s: %s", detail, c)
+				}
+			}
+			resp.Diagnostics.AddError(
+				"Step Functions Execution Failed",
+				fmt.Sprintf("Execution %s did not succeed: %s", executionArn, detail),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Step Functions Execution",
+				fmt.Sprintf("Error while waiting for execution %s: %s", executionArn, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Execution %s completed successfully", executionArn)
+
+	tflog.Info(ctx, "Step Functions execution succeeded", map[string]any{
+		"execution_arn": executionArn,
+	})
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+// findExecutionHistoryEvents returns the execution's history events with IDs greater than afterID, oldest first.
+// The history is read newest first, so only the pages containing those events are read.
+func findExecutionHistoryEvents(ctx context.Context, conn *sfn.Client, arn string, afterID int64) ([]awstypes.HistoryEvent, error) {
+	input := sfn.GetExecutionHistoryInput{
+		ExecutionArn:         aws.String(arn),
+		IncludeExecutionData: aws.Bool(false),
+		ReverseOrder:         true,
+	}
+	var output []awstypes.HistoryEvent
+
+	pages := sfn.NewGetExecutionHistoryPaginator(conn, &input)
+pages:
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Events {
+			if v.Id <= afterID {
+				break pages
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	slices.Reverse(output)
+
+	return output, nil
+}
+
+// unqualifiedStateMachineARN returns the ARN of the state machine that a version- or alias-qualified ARN refers to.
+func unqualifiedStateMachineARN(v string) string {
+	parsedARN, err := arn.Parse(v)
+	if err != nil {
+		return v
+	}
+
+	// "stateMachine:<name>[:<version or alias>]".
+	if parts := strings.Split(parsedARN.Resource, ":"); len(parts) > 2 {
+		parsedARN.Resource = strings.Join(parts[:2], ":")
+	}
+
+	return parsedARN.String()
 }
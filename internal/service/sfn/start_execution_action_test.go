@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -89,6 +91,107 @@ func TestAccSFNStartExecutionAction_emptyInput(t *testing.T) {
 	})
 }
 
+func TestAccSFNStartExecutionAction_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartExecutionActionStatus(ctx, t, rName, awstypes.ExecutionStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_waitForCompletionFailed(rName),
+				ExpectError: regexache.MustCompile(`Step Functions Execution Failed`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionExpress(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_waitForCompletionExpress(rName),
+				ExpectError: regexache.MustCompile(`Unsupported Step Functions State Machine Type`),
+			},
+		},
+	})
+}
+
+func TestUnqualifiedStateMachineARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn  string
+		want string
+	}{
+		"unqualified": {
+			arn:  "arn:aws:states:us-west-2:123456789012:stateMachine:test",
+			want: "arn:aws:states:us-west-2:123456789012:stateMachine:test",
+		},
+		"version": {
+			arn:  "arn:aws:states:us-west-2:123456789012:stateMachine:test:1",
+			want: "arn:aws:states:us-west-2:123456789012:stateMachine:test",
+		},
+		"alias": {
+			arn:  "arn:aws:states:us-west-2:123456789012:stateMachine:test:prod",
+			want: "arn:aws:states:us-west-2:123456789012:stateMachine:test",
+		},
+		"not an ARN": {
+			arn:  "test",
+			want: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfsfn.UnqualifiedStateMachineARN(testCase.arn); got != testCase.want {
+				t.Errorf("UnqualifiedStateMachineARN(%q) = %q, want %q", testCase.arn, got, testCase.want)
+			}
+		})
+	}
+}
+
 // Test helper functions
 
 func testAccCheckStartExecutionAction(ctx context.Context, t *testing.T, stateMachineName, expectedInput string) resource.TestCheckFunc {
@@ -200,6 +303,47 @@ func testAccCheckStartExecutionActionWithName(ctx context.Context, t *testing.T,
 	}
 }
 
+func testAccCheckStartExecutionActionStatus(ctx context.Context, t *testing.T, stateMachineName string, expectedStatus awstypes.ExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SFNClient(ctx)
+
+		stateMachines, err := conn.ListStateMachines(ctx, &sfn.ListStateMachinesInput{})
+		if err != nil {
+			return fmt.Errorf("failed to list state machines: %w", err)
+		}
+
+		var stateMachineArn string
+		for _, sm := range stateMachines.StateMachines {
+			if *sm.Name == stateMachineName {
+				stateMachineArn = *sm.StateMachineArn
+				break
+			}
+		}
+
+		if stateMachineArn == "" {
+			return fmt.Errorf("state machine %s not found", stateMachineName)
+		}
+
+		executions, err := conn.ListExecutions(ctx, &sfn.ListExecutionsInput{
+			StateMachineArn: &stateMachineArn,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list executions for state machine %s: %w", stateMachineName, err)
+		}
+
+		if len(executions.Executions) == 0 {
+			return fmt.Errorf("no executions found for state machine %s", stateMachineName)
+		}
+
+		// The action waited for completion, so the execution must already be in its terminal status.
+		if status := executions.Executions[0].Status; status != expectedStatus {
+			return fmt.Errorf("execution status mismatch. Expected: %s, Got: %s", expectedStatus, status)
+		}
+
+		return nil
+	}
+}
+
 // Configuration functions
 
 func testAccStartExecutionActionConfig_basic(rName, inputJSON string) string {
@@ -376,3 +520,146 @@ resource "aws_sfn_state_machine" "test" {
 }
 `, rName)
 }
+
+func testAccStartExecutionActionConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStartExecutionActionConfig_passBase(rName, `
+    StartAt = "Prepare"
+    States = {
+      Prepare = {
+        Type = "Pass"
+        Next = "Migrate"
+      }
+      Migrate = {
+        Type    = "Wait"
+        Seconds = 5
+        Next    = "Done"
+      }
+      Done = {
+        Type = "Succeed"
+      }
+    }
+`),
+		`
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_waitForCompletionFailed(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStartExecutionActionConfig_passBase(rName, `
+    StartAt = "Prepare"
+    States = {
+      Prepare = {
+        Type = "Pass"
+        Next = "Fail"
+      }
+      Fail = {
+        Type  = "Fail"
+        Error = "MigrationFailed"
+        Cause = "schema version mismatch"
+      }
+    }
+`),
+		`
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_waitForCompletionExpress(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStartExecutionActionConfig_passBaseType(rName, "EXPRESS", `
+    StartAt = "Done"
+    States = {
+      Done = {
+        Type = "Succeed"
+      }
+    }
+`),
+		`
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartExecutionActionConfig_passBase(rName, states string) string {
+	return testAccStartExecutionActionConfig_passBaseType(rName, "STANDARD", states)
+}
+
+func testAccStartExecutionActionConfig_passBaseType(rName, typ, states string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "states" {
+  service_name = "states"
+}
+
+resource "aws_iam_role" "for_sfn" {
+  name = "%[1]s-sfn"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.states.name
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = %[2]q
+
+  definition = jsonencode({
+%[3]s  })
+}
+`, rName, typ, states)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/mockaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -76,6 +77,55 @@ func TestAccSSMParameter_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_mockBasic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var param awstypes.Parameter
+	backend := mockaws.NewBackend(mockaws.WithRegion(acctest.Region()))
+	name := fmt.Sprintf("%s_%s", t.Name(), acctest.RandString(t, 10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactoriesWithMockAWS(ctx, t, backend),
+		CheckDestroy:             testAccCheckParameterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterConfig_basic(name, "String", "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterExists(ctx, t, resourceName, &param),
+					acctest.CheckResourceAttrRegionalARNAccountID(resourceName, names.AttrARN, "ssm", backend.AccountID(), fmt.Sprintf("parameter/%s", name)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "String"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "test"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				Config: testAccParameterConfig_basicTags1(name, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterExists(ctx, t, resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, name),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						acctest.CtKey1: knownvalue.StringExact(acctest.CtValue1),
+					})),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"has_value_wo"},
+			},
+		},
+	})
+}
+
 // TestAccSSMParameter_multiple is mostly a performance benchmark
 func TestAccSSMParameter_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
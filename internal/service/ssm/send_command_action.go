@@ -0,0 +1,370 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                 `tfsdk:"comment"`
+	DocumentName    types.String                                 `tfsdk:"document_name"`
+	DocumentVersion types.String                                 `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                         `tfsdk:"instance_ids"`
+	Parameters      fwtypes.MapValueOf[fwtypes.ListOfString]     `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM Run Command document on managed instances and waits for the command to complete, streaming per-instance status and output.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the SSM document to run, e.g. AWS-RunShellScript",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the SSM document to run. Defaults to the default version of the document",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				Description: "The IDs of the managed instances on which to run the command. Exactly one of instance_ids or targets must be specified",
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+					listvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+				},
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "The parameters to pass to the document, e.g. commands for AWS-RunShellScript",
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				Description: "Selects the managed instances on which to run the command by tag or resource group",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "The target key, e.g. tag:Environment or InstanceIds",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							Description: "The target values",
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 30*time.Minute)
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs),
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		"instance_ids":    input.InstanceIds,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Sending SSM command %s...", documentName)
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	cb(ctx, "Command %s sent, waiting for completion...", commandID)
+
+	// Report each instance's status as it changes.
+	reported := make(map[string]awstypes.CommandInvocationStatus)
+	reportInvocations := func(invocations []awstypes.CommandInvocation) {
+		for _, v := range invocations {
+			instanceID := aws.ToString(v.InstanceId)
+			if reported[instanceID] == v.Status {
+				continue
+			}
+			reported[instanceID] = v.Status
+
+			cb(ctx, "Command %s on %s: %s", commandID, instanceID, v.Status)
+		}
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*commandProgress], error) {
+		progress, err := findCommandProgressByID(ctx, conn, commandID)
+		if retry.NotFound(err) {
+			// Eventual consistency.
+			return actionwait.FetchResult[*commandProgress]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*commandProgress]{}, fmt.Errorf("describing command: %w", err)
+		}
+		return actionwait.FetchResult[*commandProgress]{Status: actionwait.Status(progress.command.Status), Value: progress}, nil
+	}, actionwait.Options[*commandProgress]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.CommandStatusSuccess)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*commandProgress); ok && v != nil {
+				reportInvocations(v.invocations)
+			}
+		},
+	})
+
+	// Report the final status and output of each instance, whether or not the command succeeded.
+	if result.Value != nil {
+		reportInvocations(result.Value.invocations)
+
+		for _, v := range result.Value.invocations {
+			for _, plugin := range v.CommandPlugins {
+				if output := aws.ToString(plugin.Output); output != "" {
+					cb(ctx, "Output of %s on %s:\n%s", aws.ToString(plugin.Name), aws.ToString(v.InstanceId), truncateOutput(output))
+				}
+			}
+		}
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s did not complete within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("SSM command %s did not succeed: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for SSM command %s: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Command %s completed successfully", commandID)
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id": commandID,
+	})
+}
+
+// commandProgress is the latest state of a command and its per-instance invocations.
+type commandProgress struct {
+	command     *awstypes.Command
+	invocations []awstypes.CommandInvocation
+}
+
+func findCommandProgressByID(ctx context.Context, conn *ssm.Client, id string) (*commandProgress, error) {
+	command, err := findCommandByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	invocations, err := findCommandInvocations(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &commandProgress{
+		command:     command,
+		invocations: invocations,
+	}, nil
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	return findCommand(ctx, conn, &input)
+}
+
+func findCommand(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) (*awstypes.Command, error) {
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// maxProgressOutputLength is the maximum length of command or automation output included in a progress event.
+const maxProgressOutputLength = 2048
+
+// truncateOutput truncates output for inclusion in a progress event.
+func truncateOutput(s string) string {
+	if len(s) <= maxProgressOutputLength {
+		return s
+	}
+
+	return s[:maxProgressOutputLength] + "\n...(truncated)"
+}
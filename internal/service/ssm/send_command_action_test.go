@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendCommandActionConfig_targets(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_invalidInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSendCommandActionConfig_invalidInstance(),
+				ExpectError: regexache.MustCompile(`InvalidInstanceId`),
+			},
+		},
+	})
+}
+
+// The targets match no managed instances, so the command succeeds without running anywhere.
+func testAccSendCommandActionConfig_targets(rName string) string {
+	return fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    comment       = %[1]q
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+
+    targets {
+      key    = "tag:Name"
+      values = [%[1]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSendCommandActionConfig_invalidInstance() string {
+	return `
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = ["i-00000000000000000"]
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`
+}
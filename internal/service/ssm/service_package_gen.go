@@ -22,6 +22,22 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newSendCommandAction,
+			TypeName: "aws_ssm_send_command",
+			Name:     "Send Command",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newStartAutomationExecutionAction,
+			TypeName: "aws_ssm_start_automation_execution",
+			Name:     "Start Automation Execution",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
 		{
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName    types.String                             `tfsdk:"document_name"`
+	DocumentVersion types.String                             `tfsdk:"document_version"`
+	Parameters      fwtypes.MapValueOf[fwtypes.ListOfString] `tfsdk:"parameters"`
+	Timeout         types.Int64                              `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook and waits for the execution to complete, streaming step status and outputs.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the Automation runbook to run. Defaults to the default version of the runbook",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "The parameters to pass to the runbook",
+				CustomType:  fwtypes.NewMapTypeOf[fwtypes.ListOfString](ctx),
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := fwflex.StringValueFromFramework(ctx, config.DocumentName)
+	timeout := fwactions.TimeoutOr(config.Timeout, 60*time.Minute)
+
+	input := ssm.StartAutomationExecutionInput{
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+	}
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting SSM Automation execution of %s...", documentName)
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start SSM Automation execution of %s: %s", documentName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+
+	cb(ctx, "Automation execution %s started, waiting for completion...", executionID)
+
+	// Report each step's status as it changes.
+	reported := make(map[string]awstypes.AutomationExecutionStatus)
+	reportSteps := func(steps []awstypes.StepExecution) {
+		for _, v := range steps {
+			stepID := aws.ToString(v.StepExecutionId)
+			if reported[stepID] == v.StepStatus {
+				continue
+			}
+			reported[stepID] = v.StepStatus
+
+			if message := aws.ToString(v.FailureMessage); message != "" {
+				cb(ctx, "Step %s (%s): %s: %s", aws.ToString(v.StepName), aws.ToString(v.Action), v.StepStatus, message)
+			} else {
+				cb(ctx, "Step %s (%s): %s", aws.ToString(v.StepName), aws.ToString(v.Action), v.StepStatus)
+			}
+		}
+	}
+
+	result, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		output, err := findAutomationExecutionByID(ctx, conn, executionID)
+		if retry.NotFound(err) {
+			// Eventual consistency.
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(awstypes.AutomationExecutionStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("describing automation execution: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(output.AutomationExecutionStatus), Value: output}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.WithBackoffDelay(backoff.DefaultSDKv2HelperRetryCompatibleDelay()),
+		ProgressInterval: time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*awstypes.AutomationExecution); ok && v != nil {
+				reportSteps(v.StepExecutions)
+			}
+		},
+	})
+
+	// Report the final status of each step and the execution's outputs, whether or not the execution succeeded.
+	if v := result.Value; v != nil {
+		reportSteps(v.StepExecutions)
+
+		for _, k := range slices.Sorted(maps.Keys(v.Outputs)) {
+			cb(ctx, "Output %s:\n%s", k, truncateOutput(strings.Join(v.Outputs[k], "\n")))
+		}
+	}
+
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("SSM Automation execution %s did not complete within %s: %s", executionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			detail := err.Error()
+			if v := result.Value; v != nil && aws.ToString(v.FailureMessage) != "" {
+				detail = fmt.Sprintf("%s: %s", detail, aws.ToString(v.FailureMessage))
+			}
+			resp.Diagnostics.AddError(
+				"Automation Execution Failed",
+				fmt.Sprintf("SSM Automation execution %s did not succeed: %s", executionID, detail),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Automation Execution",
+				fmt.Sprintf("Error while waiting for SSM Automation execution %s: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	cb(ctx, "Automation execution %s completed successfully", executionID)
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+	})
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.AutomationExecution, nil
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartAutomationExecutionActionConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`Automation Execution Failed`),
+			},
+		},
+	})
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+parameters:
+  Duration:
+    type: String
+    default: PT1S
+mainSteps:
+  - name: wait
+    action: aws:sleep
+    inputs:
+      Duration: '{{ Duration }}'
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+
+    parameters = {
+      Duration = ["PT2S"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+
+  depends_on = [aws_ssm_document.test]
+}
+`, rName)
+}
+
+func testAccStartAutomationExecutionActionConfig_failed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+mainSteps:
+  - name: describe
+    action: aws:executeAwsApi
+    inputs:
+      Service: ec2
+      Api: DescribeInstances
+      InstanceIds:
+        - i-00000000000000000
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+
+  depends_on = [aws_ssm_document.test]
+}
+`, rName)
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
 		Name: "aws_storagegateway_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
 		Name: "aws_storagegateway_tape_pool",
 		F:    sweepTapePools,
 	})
 
-	resource.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_file_system_association", &resource.Sweeper{
 		Name: "aws_storagegateway_file_system_association",
 		F:    sweepFileSystemAssociations,
 	})
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newAssumeRoleEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleEphemeralResource{}, nil
+}
+
+type assumeRoleEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleEphemeralResourceModel]
+}
+
+func (e *assumeRoleEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID that identifies the temporary security credentials.",
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ARN of the temporary security credentials, in the form `arn:aws:sts::123456789012:assumed-role/role-name/role-session-name`.",
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "A unique identifier that contains the role ID and the role session name of the assumed role.",
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(900, 43200),
+				},
+				Description: "The duration, in seconds, of the role session. Value can range from 900 to 43200 seconds. Default is 3600 seconds (1 hour).",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the temporary security credentials in RFC3339 format.",
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+				},
+				Description: "A unique identifier that might be required when you assume a role in another account.",
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType:  fwtypes.IAMPolicyType,
+				Optional:    true,
+				Description: "An IAM policy in JSON format to use as an inline session policy.",
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+				},
+				Description: "The ARNs of the IAM managed policies to use as managed session policies. Must contain at most 10 items.",
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				Description: "The ARN of the role to assume.",
+			},
+			"role_session_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+				Description: "An identifier for the assumed role session.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret access key that can be used to sign requests.",
+			},
+			"session_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token that users must pass to the service API to use the temporary credentials.",
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+				Description: "The source identity specified by the principal that is calling the `AssumeRole` operation.",
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+				Description: "A set of session tag keys that are passed to subsequent sessions in a role chain.",
+			},
+		},
+	}
+}
+
+func (e *assumeRoleEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	var data assumeRoleEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input sts.AssumeRoleInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("PolicyARNs"), fwflex.WithIgnoredFieldNamesAppend("Tags")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	// expand tags since this is not using transparent tagging
+	if !data.Tags.IsNull() {
+		tags := tftags.New(ctx, data.Tags)
+		sessionTags := make([]awstypes.Tag, 0, len(tags.Map()))
+		for k, v := range tags.Map() {
+			tag := awstypes.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			}
+
+			sessionTags = append(sessionTags, tag)
+		}
+
+		input.Tags = sessionTags
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.RoleARN.ValueString())
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.Credentials, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleEphemeralResourceModel struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	DurationSeconds   types.Int32         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	RoleSessionName   types.String        `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              tftags.Map          `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
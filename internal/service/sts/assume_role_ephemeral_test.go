@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source:            "hashicorp/time",
+				VersionConstraint: "0.12.1",
+			},
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_access_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_arn"), knownvalue.StringRegexp(regexache.MustCompile(`assumed-role/`+rName+`/`+rName+`$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("assumed_role_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {
+				Source:            "hashicorp/time",
+				VersionConstraint: "0.12.1",
+			},
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_full(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("session_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("source_identity"), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccAssumeRoleEphemeralConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = ["sts:AssumeRole", "sts:TagSession", "sts:SetSourceIdentity"]
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+# Wait for the role's trust policy to eventually take effect before assuming it.
+resource "time_sleep" "test" {
+  create_duration = "10s"
+  depends_on      = [aws_iam_role.test]
+}
+`, rName)
+}
+
+func testAccAssumeRoleEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn          = aws_iam_role.test.arn
+  role_session_name = %[1]q
+
+  depends_on = [time_sleep.test]
+}
+`, rName))
+}
+
+func testAccAssumeRoleEphemeralConfig_full(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAssumeRoleEphemeralConfig_base(rName),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn            = aws_iam_role.test.arn
+  role_session_name   = %[1]q
+  duration_seconds    = 900
+  source_identity     = %[1]q
+  transitive_tag_keys = ["environment"]
+  policy_arns         = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"]
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListAllMyBuckets"
+      Resource = "*"
+    }]
+  })
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [time_sleep.test]
+}
+`, rName))
+}
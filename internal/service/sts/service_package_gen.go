@@ -21,6 +21,12 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newAssumeRoleEphemeralResource,
+			TypeName: "aws_sts_assume_role",
+			Name:     "Assume Role",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
 		{
 			Factory:  newWebIdentityTokenEphemeralResource,
 			TypeName: "aws_sts_web_identity_token",
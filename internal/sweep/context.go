@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 )
 
 func Context(region string) context.Context {
@@ -17,5 +18,7 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = inventory.WithRegion(ctx, region)
+
 	return ctx
 }
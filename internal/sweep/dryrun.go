@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names which
+// do not change resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dryRunMiddlewareStackMutator rejects every AWS API operation which may change a resource.
+// It prevents sweepers which have not been written to support dry runs from
+// deleting resources during a dry run.
+func dryRunMiddlewareStackMutator(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweeperDryRun", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operation) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("dry run, not calling %s.%s", awsmiddleware.GetServiceID(ctx), operation)
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
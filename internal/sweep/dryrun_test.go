@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"BatchGetItem":              true,
+		"DescribeRouteTables":       true,
+		"GetBucketLocation":         true,
+		"HeadBucket":                true,
+		"ListQueues":                true,
+		"DeleteRouteTable":          false,
+		"DisassociateRouteTable":    false,
+		"RevokeSecurityGroupEgress": false,
+		"UpdateTable":               false,
+		"":                          false,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOnlyOperation(operation); got != want {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
+
+func TestDryRunMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := sqs.New(sqs.Options{
+		APIOptions:  []func(*middleware.Stack) error{dryRunMiddlewareStackMutator},
+		Credentials: aws.AnonymousCredentials{},
+		Region:      "us-west-2", //lintignore:AWSAT003
+	})
+
+	_, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{
+		QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test"), //lintignore:AWSAT003,AWSAT005
+	})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, want := err.Error(), "dry run, not calling SQS.DeleteQueue"; !strings.Contains(got, want) {
+		t.Errorf("error = %q, want it to contain %q", got, want)
+	}
+}
@@ -5,6 +5,8 @@ package framework
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,9 +36,10 @@ func NewAttribute(path string, value any) attribute {
 }
 
 type sweepResource struct {
-	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
-	meta       *conns.AWSClient
-	attributes []attribute
+	factory       func(context.Context) (fwresource.ResourceWithConfigure, error)
+	meta          *conns.AWSClient
+	attributes    []attribute
+	inventoryOpts []inventory.Option
 }
 
 func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), meta *conns.AWSClient, attributes ...attribute) *sweepResource {
@@ -46,6 +50,47 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
+// WithInventory sets options which describe the resource in sweeper reports.
+func (sr *sweepResource) WithInventory(optFns ...inventory.Option) *sweepResource {
+	sr.inventoryOpts = append(sr.inventoryOpts, optFns...)
+	return sr
+}
+
+func (sr *sweepResource) Describe(ctx context.Context) inventory.Resource {
+	r := inventory.FromContext(ctx)
+
+	// Resources without an "id" attribute are identified by all their sweeper attributes.
+	var values []string
+	for _, attr := range sr.attributes {
+		var value string
+		switch v := attr.value.(type) {
+		case map[string]string:
+			if attr.path == names.AttrTags {
+				r.Tags = v
+			}
+			continue
+		case *string:
+			value = aws.ToString(v)
+		default:
+			value = fmt.Sprint(v)
+		}
+
+		if attr.path == names.AttrID {
+			r.ID = value
+		}
+		values = append(values, value)
+	}
+	if r.ID == "" {
+		r.ID = strings.Join(values, ",")
+	}
+
+	for _, optFn := range sr.inventoryOpts {
+		optFn(&r)
+	}
+
+	return r
+}
+
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
 	resource, err := sr.factory(ctx)
 	if err != nil {
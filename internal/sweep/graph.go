@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const defaultSweeperParallelism = 1
+
+// TestMain runs sweepers in dependency order when the -sweep flag is set,
+// otherwise tests are executed as normal.
+//
+// The -sweep, -sweep-run and -sweep-allow-failures flags are those defined by the
+// Terraform Plugin Testing framework. Sweepers at the same depth in the dependency
+// graph are run concurrently, up to the limit set by the TF_AWS_SWEEP_PARALLELISM
+// environment variable.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+	sweepers := filterSweepers(flagValue("sweep-run"), registeredSweepers)
+
+	if err := RunSweepers(strings.Split(regions, ","), sweepers, sweeperParallelism(), allowFailures); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+func sweeperParallelism() int {
+	if v, err := strconv.Atoi(os.Getenv(envvar.SweepParallelism)); err == nil && v > 0 {
+		return v
+	}
+
+	return defaultSweeperParallelism
+}
+
+// filterSweepers returns the sweepers whose names contain any of the
+// comma-separated filter values, together with all of their dependencies.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	sweepers := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := sweepers[name]; ok {
+			return
+		}
+		s, ok := source[name]
+		if !ok {
+			return
+		}
+		sweepers[name] = s
+		for _, dep := range s.Dependencies {
+			add(dep)
+		}
+	}
+
+	for name := range source {
+		for v := range strings.SplitSeq(strings.ToLower(filter), ",") {
+			if strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return sweepers
+}
+
+// sweeperLevels groups sweepers by their depth in the dependency graph.
+// Every sweeper's dependencies are in earlier levels. Dependencies which are not
+// registered sweepers are returned separately.
+func sweeperLevels(sweepers map[string]*resource.Sweeper) ([][]string, map[string][]string, error) {
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	missing := make(map[string][]string)
+	for _, name := range names {
+		for _, dep := range sweepers[name].Dependencies {
+			if !g.HasNode(dep) {
+				missing[name] = append(missing[name], dep)
+				continue
+			}
+
+			if err := g.AddDependency(name, dep); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	var levels [][]string
+	depths := make(map[string]int, len(order))
+	for _, name := range order {
+		deps, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		depth := 0
+		for _, dep := range deps {
+			depth = max(depth, depths[dep]+1)
+		}
+		depths[name] = depth
+
+		for len(levels) <= depth {
+			levels = append(levels, nil)
+		}
+		levels[depth] = append(levels[depth], name)
+	}
+
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return levels, missing, nil
+}
+
+type sweeperResult struct {
+	err       error
+	blockedBy []string
+	skipped   bool
+}
+
+func (r sweeperResult) ok() bool {
+	return r.err == nil && len(r.blockedBy) == 0 && !r.skipped
+}
+
+// RunSweepers runs sweepers in each Region in dependency order.
+// A sweeper is blocked, and not run, if any of its dependencies failed or were
+// blocked. Dependencies which are not registered sweepers are treated as
+// satisfied. Unless allowFailures is set, no further levels are run after a
+// sweeper fails.
+func RunSweepers(regions []string, sweepers map[string]*resource.Sweeper, parallelism int, allowFailures bool) error {
+	levels, missing, err := sweeperLevels(sweepers)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(missing)) {
+		for _, dep := range missing[name] {
+			log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+		}
+	}
+
+	var failed bool
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running Sweepers for region (%s) in %d levels", region, len(levels))
+
+		results := runSweeperLevels(region, sweepers, levels, parallelism, allowFailures)
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+		if !logSweeperResults(region, results) {
+			failed = true
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	if failed {
+		return errors.New("at least one sweeper failed or was blocked")
+	}
+
+	return nil
+}
+
+func runSweeperLevels(region string, sweepers map[string]*resource.Sweeper, levels [][]string, parallelism int, allowFailures bool) map[string]sweeperResult {
+	var mu sync.Mutex
+	results := make(map[string]sweeperResult)
+	stop := false
+
+	for i, level := range levels {
+		if stop {
+			for _, name := range level {
+				results[name] = sweeperResult{skipped: true}
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] Running Sweepers for region (%s), level %d: %s", region, i, strings.Join(level, ", "))
+
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, max(parallelism, 1))
+
+		for _, name := range level {
+			s := sweepers[name]
+
+			var blockedBy []string
+			mu.Lock()
+			for _, dep := range s.Dependencies {
+				if r, ok := results[dep]; ok && !r.ok() {
+					blockedBy = append(blockedBy, dep)
+				}
+			}
+			mu.Unlock()
+
+			if len(blockedBy) > 0 {
+				log.Printf("[WARN] Sweeper (%s) in region (%s) is blocked by: %s", name, region, strings.Join(blockedBy, ", "))
+				mu.Lock()
+				results[name] = sweeperResult{blockedBy: blockedBy}
+				mu.Unlock()
+				continue
+			}
+
+			semaphore <- struct{}{}
+			wg.Go(func() {
+				defer func() { <-semaphore }()
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				err := s.F(region)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+				if err != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+				}
+
+				mu.Lock()
+				results[name] = sweeperResult{err: err}
+				mu.Unlock()
+			})
+		}
+
+		wg.Wait()
+
+		if !allowFailures {
+			for _, name := range level {
+				if results[name].err != nil {
+					stop = true
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+// logSweeperResults logs the outcome of each sweeper and returns whether all succeeded.
+func logSweeperResults(region string, results map[string]sweeperResult) bool {
+	var succeeded, failed, blocked, skipped []string
+
+	for _, name := range slices.Sorted(maps.Keys(results)) {
+		switch r := results[name]; {
+		case r.skipped:
+			skipped = append(skipped, fmt.Sprintf("\t- %s\n", name))
+		case len(r.blockedBy) > 0:
+			blocked = append(blocked, fmt.Sprintf("\t- %s: blocked by %s\n", name, strings.Join(r.blockedBy, ", ")))
+		case r.err != nil:
+			failed = append(failed, fmt.Sprintf("\t- %s: %s\n", name, r.err))
+		default:
+			succeeded = append(succeeded, fmt.Sprintf("\t- %s\n", name))
+		}
+	}
+
+	log.Printf("Sweeper Tests for region (%s) ran successfully:\n%s", region, strings.Join(succeeded, ""))
+	if len(failed) > 0 {
+		log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n%s", region, strings.Join(failed, ""))
+	}
+	if len(blocked) > 0 {
+		log.Printf("Sweeper Tests for region (%s) were blocked by failed dependencies:\n%s", region, strings.Join(blocked, ""))
+	}
+	if len(skipped) > 0 {
+		log.Printf("Sweeper Tests for region (%s) were not run after an earlier failure:\n%s", region, strings.Join(skipped, ""))
+	}
+
+	return len(failed) == 0 && len(blocked) == 0 && len(skipped) == 0
+}
@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSweeperLevels(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_vpc":               {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+		"aws_subnet":            {Name: "aws_subnet", Dependencies: []string{"aws_network_interface", "aws_instance"}},
+		"aws_network_interface": {Name: "aws_network_interface", Dependencies: []string{"aws_instance"}},
+		"aws_instance":          {Name: "aws_instance"},
+		"aws_internet_gateway":  {Name: "aws_internet_gateway", Dependencies: []string{"aws_not_registered"}},
+	}
+
+	levels, missing, err := sweeperLevels(sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantLevels := [][]string{
+		{"aws_instance", "aws_internet_gateway"},
+		{"aws_network_interface"},
+		{"aws_subnet"},
+		{"aws_vpc"},
+	}
+	if diff := cmp.Diff(levels, wantLevels); diff != "" {
+		t.Errorf("unexpected levels diff (+wanted, -got): %s", diff)
+	}
+
+	wantMissing := map[string][]string{
+		"aws_internet_gateway": {"aws_not_registered"},
+	}
+	if diff := cmp.Diff(missing, wantMissing); diff != "" {
+		t.Errorf("unexpected missing diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSweeperLevelsCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"a": {Name: "a", Dependencies: []string{"b"}},
+		"b": {Name: "b", Dependencies: []string{"c"}},
+		"c": {Name: "c", Dependencies: []string{"a"}},
+	}
+
+	_, _, err := sweeperLevels(sweepers)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if !strings.Contains(err.Error(), "dependency cycle") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*resource.Sweeper{
+		"aws_vpc":      {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":   {Name: "aws_subnet", Dependencies: []string{"aws_instance"}},
+		"aws_instance": {Name: "aws_instance"},
+		"aws_s3_bucket": {
+			Name: "aws_s3_bucket",
+		},
+	}
+
+	testCases := map[string]struct {
+		filter string
+		want   []string
+	}{
+		"empty": {
+			filter: "",
+			want:   []string{"aws_instance", "aws_s3_bucket", "aws_subnet", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter: "aws_VPC",
+			want:   []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"multiple": {
+			filter: "instance,bucket",
+			want:   []string{"aws_instance", "aws_s3_bucket"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Sorted(maps.Keys(filterSweepers(testCase.filter, sweepers)))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweeperLevels(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		want          map[string]string
+	}{
+		"allow failures": {
+			allowFailures: true,
+			want: map[string]string{
+				"aws_instance":         "ok",
+				"aws_internet_gateway": "ok",
+				"aws_route_table":      "ok",
+				"aws_subnet":           "failed",
+				"aws_vpc":              "blocked",
+				"aws_vpc_dhcp_options": "blocked",
+			},
+		},
+		"stop on failure": {
+			want: map[string]string{
+				"aws_instance":         "ok",
+				"aws_internet_gateway": "ok",
+				"aws_route_table":      "ok",
+				"aws_subnet":           "failed",
+				"aws_vpc":              "skipped",
+				"aws_vpc_dhcp_options": "skipped",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var ran []string
+			f := func(name string, err error) resource.SweeperFunc {
+				return func(string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					ran = append(ran, name)
+					return err
+				}
+			}
+
+			sweepers := map[string]*resource.Sweeper{
+				"aws_vpc":              {Name: "aws_vpc", Dependencies: []string{"aws_subnet", "aws_route_table"}, F: f("aws_vpc", nil)},
+				"aws_subnet":           {Name: "aws_subnet", Dependencies: []string{"aws_instance"}, F: f("aws_subnet", errors.New("DependencyViolation"))},
+				"aws_route_table":      {Name: "aws_route_table", F: f("aws_route_table", nil)},
+				"aws_instance":         {Name: "aws_instance", F: f("aws_instance", nil)},
+				"aws_internet_gateway": {Name: "aws_internet_gateway", Dependencies: []string{"aws_not_registered"}, F: f("aws_internet_gateway", nil)},
+				"aws_vpc_dhcp_options": {Name: "aws_vpc_dhcp_options", Dependencies: []string{"aws_vpc"}, F: f("aws_vpc_dhcp_options", nil)},
+			}
+
+			levels, missing, err := sweeperLevels(sweepers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(missing, map[string][]string{"aws_internet_gateway": {"aws_not_registered"}}); diff != "" {
+				t.Errorf("unexpected missing diff (+wanted, -got): %s", diff)
+			}
+
+			results := runSweeperLevels("us-west-2", sweepers, levels, 2, testCase.allowFailures) //lintignore:AWSAT003
+
+			got := make(map[string]string)
+			for name, r := range results {
+				switch {
+				case r.skipped:
+					got[name] = "skipped"
+				case len(r.blockedBy) > 0:
+					got[name] = "blocked"
+				case r.err != nil:
+					got[name] = "failed"
+				default:
+					got[name] = "ok"
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected results diff (+wanted, -got): %s", diff)
+			}
+
+			if slices.Contains(ran, "aws_vpc") || slices.Contains(ran, "aws_vpc_dhcp_options") {
+				t.Errorf("blocked or skipped sweeper was run: %v", ran)
+			}
+			if !slices.Contains(ran, "aws_internet_gateway") {
+				t.Errorf("sweeper with unregistered dependency was not run: %v", ran)
+			}
+			if i, j := slices.Index(ran, "aws_instance"), slices.Index(ran, "aws_subnet"); j >= 0 && i > j {
+				t.Errorf("sweeper ran before its dependency: %v", ran)
+			}
+		})
+	}
+}
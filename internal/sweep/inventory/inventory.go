@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package inventory describes the resources found by sweepers so that they
+// can be reported on instead of, or as well as, being deleted.
+package inventory
+
+import (
+	"context"
+	"time"
+)
+
+// Resource describes a single resource found by a sweeper.
+type Resource struct {
+	Region       string            `json:"region"`
+	ResourceType string            `json:"resource_type"`
+	ID           string            `json:"id"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	CreatedAt    *time.Time        `json:"created_at,omitempty"`
+	Age          string            `json:"age,omitempty"`
+}
+
+// Describer is implemented by sweepables which can describe the resource they
+// would delete.
+type Describer interface {
+	Describe(ctx context.Context) Resource
+}
+
+// Option sets optional details on a Resource.
+type Option func(*Resource)
+
+// WithCreatedAt sets the time the resource was created, from which its age is
+// reported.
+func WithCreatedAt(t time.Time) Option {
+	return func(r *Resource) {
+		if !t.IsZero() {
+			r.CreatedAt = &t
+		}
+	}
+}
+
+// WithTags sets the resource's tags.
+func WithTags(tags map[string]string) Option {
+	return func(r *Resource) {
+		if len(tags) > 0 {
+			r.Tags = tags
+		}
+	}
+}
+
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
+// WithRegion returns a new Context that carries the Region being swept.
+func WithRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey, region)
+}
+
+// WithResourceType returns a new Context that carries the resource type being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeKey, resourceType)
+}
+
+// FromContext returns a Resource with the Region and resource type carried by ctx.
+func FromContext(ctx context.Context) Resource {
+	var r Resource
+
+	if v, ok := ctx.Value(regionKey).(string); ok {
+		r.Region = v
+	}
+	if v, ok := ctx.Value(resourceTypeKey).(string); ok {
+		r.ResourceType = v
+	}
+
+	return r
+}
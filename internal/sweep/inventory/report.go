@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sweeper identifies a sweeper run in a Region.
+type Sweeper struct {
+	Region       string `json:"region"`
+	ResourceType string `json:"resource_type"`
+}
+
+// Report accumulates the resources found during a sweeper run.
+type Report struct {
+	DryRun    bool       `json:"dry_run"`
+	StartedAt time.Time  `json:"started_at"`
+	Resources []Resource `json:"resources"`
+	// NotInventoried lists the sweepers which were not run during a dry run
+	// because they delete resources directly.
+	NotInventoried []Sweeper `json:"not_inventoried,omitempty"`
+
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:    dryRun,
+		StartedAt: time.Now().UTC(),
+		Resources: make([]Resource, 0),
+		now:       time.Now,
+	}
+}
+
+// Add records resources in the report, computing the age of any resource with
+// a known creation time.
+func (r *Report) Add(resources ...Resource) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	for _, v := range resources {
+		if v.CreatedAt != nil {
+			v.Age = now.Sub(*v.CreatedAt).Truncate(time.Second).String()
+		}
+		r.Resources = append(r.Resources, v)
+	}
+}
+
+// AddNotInventoried records a sweeper whose resources are not in the report.
+func (r *Report) AddNotInventoried(sweeper Sweeper) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.NotInventoried = append(r.NotInventoried, sweeper)
+}
+
+// WriteFile writes the report as JSON to the named file, replacing any
+// existing contents.
+// The whole report is rewritten on each call so that the file is complete
+// even if the run is interrupted.
+func (r *Report) WriteFile(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling sweeper report: %w", err)
+	}
+
+	if err := os.WriteFile(name, b, 0644); err != nil { //nolint:mnd
+		return fmt.Errorf("writing sweeper report (%s): %w", name, err)
+	}
+
+	return nil
+}
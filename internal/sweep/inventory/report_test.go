@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package inventory
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-36 * time.Hour)
+
+	ctx := WithResourceType(WithRegion(t.Context(), "us-west-2"), "aws_sqs_queue") //lintignore:AWSAT003
+
+	r1 := FromContext(ctx)
+	r1.ID = "queue1"
+	for _, optFn := range []Option{WithCreatedAt(createdAt), WithTags(map[string]string{"Name": "test"})} {
+		optFn(&r1)
+	}
+
+	r2 := FromContext(ctx)
+	r2.ID = "queue2"
+	for _, optFn := range []Option{WithCreatedAt(time.Time{}), WithTags(nil)} {
+		optFn(&r2)
+	}
+
+	report := NewReport(true)
+	report.now = func() time.Time { return now }
+	report.Add(r1, r2)
+	report.AddNotInventoried(Sweeper{Region: "us-west-2", ResourceType: "aws_route_table"}) //lintignore:AWSAT003
+
+	name := filepath.Join(t.TempDir(), "report.json")
+	if err := report.WriteFile(name); err != nil {
+		t.Fatalf("WriteFile: %s", err)
+	}
+
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("ReadFile: %s", err)
+	}
+
+	var got struct {
+		DryRun         bool             `json:"dry_run"`
+		Resources      []map[string]any `json:"resources"`
+		NotInventoried []map[string]any `json:"not_inventoried"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal: %s", err)
+	}
+
+	if !got.DryRun {
+		t.Errorf("dry_run = false, want true")
+	}
+
+	want := []map[string]any{
+		{
+			"region":        "us-west-2", //lintignore:AWSAT003
+			"resource_type": "aws_sqs_queue",
+			"id":            "queue1",
+			"tags":          map[string]any{"Name": "test"},
+			"created_at":    "2026-01-01T00:00:00Z",
+			"age":           "36h0m0s",
+		},
+		{
+			"region":        "us-west-2", //lintignore:AWSAT003
+			"resource_type": "aws_sqs_queue",
+			"id":            "queue2",
+		},
+	}
+	if diff := cmp.Diff(got.Resources, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	wantNotInventoried := []map[string]any{
+		{
+			"region":        "us-west-2", //lintignore:AWSAT003
+			"resource_type": "aws_route_table",
+		},
+	}
+	if diff := cmp.Diff(got.NotInventoried, wantNotInventoried); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromContextEmpty(t *testing.T) {
+	t.Parallel()
+
+	if got, want := FromContext(t.Context()), (Resource{}); !cmp.Equal(got, want) {
+		t.Errorf("FromContext() = %+v, want %+v", got, want)
+	}
+}
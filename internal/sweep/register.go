@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// registeredSweepers mirrors the sweepers registered with the Terraform Plugin Testing
+// framework so that they can be scheduled using their dependency graph.
+var registeredSweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// All sweepers must be registered using this function, instead of directly with
+// the Terraform Plugin Testing framework, so that they are run in dependency order.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	registeredSweepers[name] = s
+}
@@ -11,25 +11,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
-	d        *schema.ResourceData
-	meta     *conns.AWSClient
-	resource *schema.Resource
+	d             *schema.ResourceData
+	meta          *conns.AWSClient
+	resource      *schema.Resource
+	inventoryOpts []inventory.Option
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *sweepResource {
-	s := newSweepResource(resource, d, meta)
+// NewSweepResource returns a Sweepable for an SDKv2 resource.
+// The optional inventory options describe the resource in sweeper reports.
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient, optFns ...inventory.Option) *sweepResource {
+	s := newSweepResource(resource, d, meta, optFns...)
 	return &s
 }
 
-func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) sweepResource {
+func newSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient, optFns ...inventory.Option) sweepResource {
 	return sweepResource{
-		d:        d,
-		meta:     meta,
-		resource: resource,
+		d:             d,
+		meta:          meta,
+		resource:      resource,
+		inventoryOpts: optFns,
 	}
 }
 
@@ -39,13 +45,35 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+func (sr *sweepResource) Describe(ctx context.Context) inventory.Resource {
+	r := inventory.FromContext(ctx)
+	r.ID = sr.d.Id()
+
+	// Include any tags the sweeper has set from the list API response.
+	if _, ok := sr.resource.SchemaMap()[names.AttrTags]; ok {
+		if v, ok := sr.d.GetOk(names.AttrTags); ok {
+			tags := make(map[string]string)
+			for k, v := range v.(map[string]any) {
+				tags[k] = v.(string)
+			}
+			r.Tags = tags
+		}
+	}
+
+	for _, optFn := range sr.inventoryOpts {
+		optFn(&r)
+	}
+
+	return r
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
 
-func NewReaderSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) *readerSweepResource {
+func NewReaderSweepResource(resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient, optFns ...inventory.Option) *readerSweepResource {
 	return &readerSweepResource{
-		sweepResource: newSweepResource(resource, d, meta),
+		sweepResource: newSweepResource(resource, d, meta, optFns...),
 	}
 }
 
@@ -5,15 +5,18 @@ package sweep
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/inventory"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -31,8 +34,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock guards sweeperClients, as sweepers may run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -95,6 +104,10 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
+	if DryRun() {
+		client.AppendAPIOptions(ctx, dryRunMiddlewareStackMutator)
+	}
+
 	sweeperClients[region] = client
 
 	return client, nil
@@ -104,20 +117,109 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// DryRun returns whether sweepers should only list the resources they would
+// delete, as set by the TF_AWS_SWEEP_DRY_RUN environment variable.
+func DryRun() bool {
+	v, _ := strconv.ParseBool(os.Getenv(envvar.SweepDryRun))
+	return v
+}
+
+// report accumulates the resources swept, or that would be swept, during this run.
+var report = sync.OnceValue(func() *inventory.Report {
+	return inventory.NewReport(DryRun())
+})
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if DryRun() {
+		for _, sweepable := range sweepables {
+			resource := describe(ctx, sweepable)
+			tflog.Info(ctx, "Dry run, not sweeping resource", map[string]any{
+				"id": resource.ID,
+			})
+			report().Add(resource)
+		}
+
+		return writeReport(ctx)
+	}
+
 	var g tfsync.Group
+	reporting := os.Getenv(envvar.SweepReportPath) != ""
 
 	for _, sweepable := range sweepables {
 		g.Go(ctx, func(ctx context.Context) error {
-			return sweepable.Delete(ctx, optFns...)
+			if err := sweepable.Delete(ctx, optFns...); err != nil {
+				return err
+			}
+
+			if reporting {
+				report().Add(describe(ctx, sweepable))
+			}
+
+			return nil
 		})
 	}
 
-	return g.Wait(ctx)
+	err := g.Wait(ctx)
+
+	return errors.Join(err, writeReport(ctx))
+}
+
+// NotInventoried records that a sweeper which deletes resources directly,
+// rather than through SweepOrchestrator, was not run during a dry run.
+// Such sweepers must call it before making any changes:
+//
+//	if sweep.DryRun() {
+//		return sweep.NotInventoried(ctx, "aws_example_thing")
+//	}
+func NotInventoried(ctx context.Context, resourceType string) error {
+	tflog.Warn(ctx, "Dry run, not running sweeper which deletes resources directly", map[string]any{
+		"resource_type": resourceType,
+	})
+
+	report().AddNotInventoried(inventory.Sweeper{
+		Region:       inventory.FromContext(ctx).Region,
+		ResourceType: resourceType,
+	})
+
+	return writeReport(ctx)
+}
+
+// describe returns the inventory details of a sweepable.
+// Region and resource type default to those of the sweeper being run.
+func describe(ctx context.Context, sweepable Sweepable) inventory.Resource {
+	resource := inventory.FromContext(ctx)
+
+	if v, ok := sweepable.(inventory.Describer); ok {
+		r := v.Describe(ctx)
+		if r.Region == "" {
+			r.Region = resource.Region
+		}
+		if r.ResourceType == "" {
+			r.ResourceType = resource.ResourceType
+		}
+		resource = r
+	}
+
+	return resource
+}
+
+// writeReport writes the sweeper report to the file named by the
+// TF_AWS_SWEEP_REPORT_PATH environment variable, if set.
+func writeReport(ctx context.Context) error {
+	name := os.Getenv(envvar.SweepReportPath)
+	if name == "" {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Writing sweeper report", map[string]any{
+		"path": name,
+	})
+
+	return report().WriteFile(name)
 }
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
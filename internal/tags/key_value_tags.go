@@ -53,6 +53,10 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path of a local tag policy file, used in place of
+	// the organizational tag policies of the target account
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -75,9 +79,18 @@ type TagPolicyConfig struct {
 	// shared across both Plugin SDK V2 and Plugin Framework based resources.
 	Severity string
 
+	// File is the path of a local tag policy file, in the Organizations tag policy
+	// syntax. When set, the required tags and tag rules are read from this file
+	// instead of the organizational tag policies of the target account.
+	File string
+
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// TagRules are the tag key capitalization and value rules defined in the
+	// effective tag policy
+	TagRules []TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule is an organizational tag policy rule for a single tag key.
+type TagPolicyRule struct {
+	// Key is the tag key, capitalized as required by the tag policy
+	Key string
+
+	// Values are the allowed tag values. A value ending in "*" allows any value
+	// with that prefix. Empty if the tag policy does not restrict values.
+	Values []string
+
+	// EnforcedFor are the Terraform resource types for which the tag policy
+	// prevents non-compliant tagging operations
+	EnforcedFor []string
+}
+
+// violation returns a description of how the tag does not comply with the rule,
+// or an empty string if the rule does not apply or the tag complies.
+func (r TagPolicyRule) violation(key, value string) string {
+	if !strings.EqualFold(key, r.Key) {
+		return ""
+	}
+
+	if key != r.Key {
+		return fmt.Sprintf("tag key %q must be capitalized as %q", key, r.Key)
+	}
+
+	if len(r.Values) == 0 || slices.ContainsFunc(r.Values, func(v string) bool {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return v == value
+	}) {
+		return ""
+	}
+
+	return fmt.Sprintf("tag %q value %q must be one of: %s", key, value, strings.Join(r.Values, ", "))
+}
+
+// TagRuleViolations returns descriptions of the tags which do not comply with the
+// key capitalization and value rules of the tag policy.
+//
+// Violations of rules which are enforced for the resource type, and so would cause
+// the tagging operation to fail, are returned separately from violations which are
+// only reported as non-compliant.
+func (c *TagPolicyConfig) TagRuleViolations(typeName string, tags KeyValueTags) (enforced, reported []string) {
+	if c == nil {
+		return nil, nil
+	}
+
+	m := tags.Map()
+	keys := slices.Sorted(maps.Keys(m))
+
+	for _, rule := range c.TagRules {
+		isEnforced := slices.Contains(rule.EnforcedFor, typeName)
+
+		for _, key := range keys {
+			v := rule.violation(key, m[key])
+			if v == "" {
+				continue
+			}
+
+			if isEnforced {
+				enforced = append(enforced, v)
+			} else {
+				reported = append(reported, v)
+			}
+		}
+	}
+
+	return enforced, reported
+}
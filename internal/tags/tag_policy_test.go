@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyConfigTagRuleViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicyConfig{
+		Severity: "error",
+		TagRules: []TagPolicyRule{
+			{
+				Key:         "CostCenter",
+				Values:      []string{"100", "200"},
+				EnforcedFor: []string{"aws_instance"},
+			},
+			{
+				Key:    "Project",
+				Values: []string{"Alpha", "Beta*"},
+			},
+			{
+				Key: "Owner",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		policy       *TagPolicyConfig
+		typeName     string
+		tags         map[string]string
+		wantEnforced []string
+		wantReported []string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"costcenter": "300",
+			},
+		},
+		{
+			name:     "compliant",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"CostCenter": "100",
+				"Project":    "Beta-2",
+				"Owner":      "anyone",
+				"Other":      "anything",
+			},
+		},
+		{
+			name:     "enforced value",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			wantEnforced: []string{
+				`tag "CostCenter" value "300" must be one of: 100, 200`,
+			},
+		},
+		{
+			name:     "not enforced for resource type",
+			policy:   policy,
+			typeName: "aws_s3_bucket",
+			tags: map[string]string{
+				"CostCenter": "300",
+			},
+			wantReported: []string{
+				`tag "CostCenter" value "300" must be one of: 100, 200`,
+			},
+		},
+		{
+			name:     "key capitalization",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"costcenter": "100",
+				"owner":      "anyone",
+			},
+			wantEnforced: []string{
+				`tag key "costcenter" must be capitalized as "CostCenter"`,
+			},
+			wantReported: []string{
+				`tag key "owner" must be capitalized as "Owner"`,
+			},
+		},
+		{
+			name:     "wildcard value",
+			policy:   policy,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Project": "Gamma",
+			},
+			wantReported: []string{
+				`tag "Project" value "Gamma" must be one of: Alpha, Beta*`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotEnforced, gotReported := testCase.policy.TagRuleViolations(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(gotEnforced, testCase.wantEnforced); diff != "" {
+				t.Errorf("unexpected enforced diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(gotReported, testCase.wantReported); diff != "" {
+				t.Errorf("unexpected reported diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// describeEffectivePolicyOutput is the subset of the DescribeEffectivePolicy
+// API response, as output by the AWS CLI, used to read a saved effective policy
+type describeEffectivePolicyOutput struct {
+	EffectivePolicy *struct {
+		PolicyContent *string
+	}
+}
+
+// ReadFile returns the required tags and the tag key capitalization and value
+// rules defined in a local tag policy file
+//
+// The file contains either a tag policy document, or the output of the AWS CLI
+// command "aws organizations describe-effective-policy --policy-type TAG_POLICY".
+// Only values set with the "@@assign" operator are read; inheritance operators
+// are ignored.
+func ReadFile(ctx context.Context, path string) (map[string]tftags.KeyValueTags, []tftags.TagPolicyRule, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading tag policy file (%s): %w", path, err)
+	}
+
+	content := string(b)
+
+	var output describeEffectivePolicyOutput
+	if err := json.Unmarshal(b, &output); err == nil && output.EffectivePolicy != nil {
+		content = aws.ToString(output.EffectivePolicy.PolicyContent)
+	}
+
+	doc, err := parseTagPolicy(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading tag policy file (%s): %w", path, err)
+	}
+
+	return doc.requiredTags(ctx), doc.tagRules(), nil
+}
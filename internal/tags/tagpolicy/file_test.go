@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestReadFile(t *testing.T) {
+	t.Parallel()
+
+	const policy = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200"]},
+      "enforced_for": {"@@assign": ["ec2:volume"]},
+      "report_required_tag_for": {"@@assign": ["ec2:instance", "ec2:volume"]}
+    },
+    "owner": {
+      "report_required_tag_for": {"@@assign": ["ec2:instance"]}
+    }
+  }
+}`
+
+	testCases := []struct {
+		name        string
+		content     string
+		expectError bool
+	}{
+		{
+			name:    "tag policy",
+			content: policy,
+		},
+		{
+			name:    "describe effective policy output",
+			content: `{"EffectivePolicy": {"PolicyType": "TAG_POLICY", "PolicyContent": ` + strconv.Quote(policy) + `}}`,
+		},
+		{
+			name:        "invalid",
+			content:     `{"tags":`,
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			path := filepath.Join(t.TempDir(), "policy.json")
+			if err := os.WriteFile(path, []byte(testCase.content), 0600); err != nil {
+				t.Fatalf("writing policy file: %s", err)
+			}
+
+			reqTags, rules, err := ReadFile(ctx, path)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			gotReqTags := make(map[string][]string, len(reqTags))
+			for k, v := range reqTags {
+				gotReqTags[k] = slices.Sorted(slices.Values(v.Keys()))
+			}
+			wantReqTags := map[string][]string{
+				"aws_ebs_volume": {"CostCenter"},
+				"aws_instance":   {"CostCenter", "owner"},
+			}
+			if diff := cmp.Diff(gotReqTags, wantReqTags); diff != "" {
+				t.Errorf("unexpected required tags diff (+wanted, -got): %s", diff)
+			}
+
+			wantRules := []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100", "200"},
+					EnforcedFor: []string{"aws_ebs_volume"},
+				},
+				{
+					Key: "owner",
+				},
+			}
+			if diff := cmp.Diff(rules, wantRules); diff != "" {
+				t.Errorf("unexpected tag rules diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadFileNotFound(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := ReadFile(t.Context(), filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// allSupported is the tag policy resource type suffix matching all supported
+// resource types of a service, e.g. "ec2:ALL_SUPPORTED"
+const allSupported = "ALL_SUPPORTED"
+
+// GetTagRules returns the tag key capitalization and value rules from the
+// effective tag policy of the calling account
+func GetTagRules(ctx context.Context, awsConfig aws.Config) ([]tftags.TagPolicyRule, error) {
+	client := organizations.NewFromConfig(awsConfig)
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: types.EffectivePolicyTypeTagPolicy,
+	})
+
+	if errs.IsA[*types.EffectivePolicyNotFoundException](err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output.EffectivePolicy == nil {
+		return nil, nil
+	}
+
+	return parseTagRules(aws.ToString(output.EffectivePolicy.PolicyContent))
+}
+
+// tagPolicyDocument is the subset of the tag policy syntax used for tag rules
+//
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html
+type tagPolicyDocument struct {
+	Tags map[string]tagPolicyTag `json:"tags"`
+}
+
+type tagPolicyTag struct {
+	TagKey               tagPolicyValue[string]   `json:"tag_key"`
+	TagValue             tagPolicyValue[[]string] `json:"tag_value"`
+	EnforcedFor          tagPolicyValue[[]string] `json:"enforced_for"`
+	ReportRequiredTagFor tagPolicyValue[[]string] `json:"report_required_tag_for"`
+}
+
+// key returns the tag key, capitalized as required by the tag policy
+func (t tagPolicyTag) key(name string) string {
+	if t.TagKey.Assign != "" {
+		return t.TagKey.Assign
+	}
+	return name
+}
+
+// tagPolicyValue is a tag policy value set with the "@@assign" value-setting operator
+type tagPolicyValue[T any] struct {
+	Assign T `json:"@@assign"`
+}
+
+// parseTagRules translates a tag policy document into tag rules, with the
+// resource types for which each rule is enforced translated to the
+// corresponding Terraform resource types
+func parseTagRules(content string) ([]tftags.TagPolicyRule, error) {
+	doc, err := parseTagPolicy(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.tagRules(), nil
+}
+
+func parseTagPolicy(content string) (*tagPolicyDocument, error) {
+	var doc tagPolicyDocument
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	return &doc, nil
+}
+
+func (doc *tagPolicyDocument) tagRules() []tftags.TagPolicyRule {
+	rules := make([]tftags.TagPolicyRule, 0, len(doc.Tags))
+	for _, name := range slices.Sorted(maps.Keys(doc.Tags)) {
+		t := doc.Tags[name]
+
+		rules = append(rules, tftags.TagPolicyRule{
+			Key:         t.key(name),
+			Values:      t.TagValue.Assign,
+			EnforcedFor: terraformResourceTypes(t.EnforcedFor.Assign),
+		})
+	}
+
+	return rules
+}
+
+// requiredTags returns a mapping of Terraform resource types to the tags
+// required by the tag policy
+func (doc *tagPolicyDocument) requiredTags(ctx context.Context) map[string]tftags.KeyValueTags {
+	m := make(map[string]tftags.KeyValueTags)
+	for _, name := range slices.Sorted(maps.Keys(doc.Tags)) {
+		t := doc.Tags[name]
+
+		newTags := tftags.New(ctx, []string{t.key(name)})
+		for _, tfType := range terraformResourceTypes(t.ReportRequiredTagFor.Assign) {
+			if v, ok := m[tfType]; ok {
+				m[tfType] = v.Merge(newTags)
+			} else {
+				m[tfType] = newTags
+			}
+		}
+	}
+	return m
+}
+
+// terraformResourceTypes translates tag policy resource types into the
+// corresponding Terraform resource types
+func terraformResourceTypes(resourceTypes []string) []string {
+	var tfTypes []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, v...)
+				}
+			}
+			continue
+		}
+
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+
+	slices.Sort(tfTypes)
+	return slices.Compact(tfTypes)
+}
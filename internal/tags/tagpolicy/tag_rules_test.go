@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParseTagRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		content     string
+		want        []tftags.TagPolicyRule
+		expectError bool
+	}{
+		{
+			name:    "empty",
+			content: `{}`,
+			want:    []tftags.TagPolicyRule{},
+		},
+		{
+			name:        "invalid",
+			content:     `{"tags":`,
+			expectError: true,
+		},
+		{
+			name: "effective policy",
+			content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200"]},
+      "enforced_for": {"@@assign": ["ec2:instance", "ec2:volume", "ec2:not-a-resource-type"]}
+    },
+    "owner": {}
+  }
+}`,
+			want: []tftags.TagPolicyRule{
+				{
+					Key:         "CostCenter",
+					Values:      []string{"100", "200"},
+					EnforcedFor: []string{"aws_ebs_volume", "aws_instance"},
+				},
+				{
+					Key: "owner",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTagRules(testCase.content)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTerraformResourceTypesAllSupported(t *testing.T) {
+	t.Parallel()
+
+	got := terraformResourceTypes([]string{"acm:ALL_SUPPORTED"})
+	want := []string{"aws_acm_certificate"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 )
 
 // servicePrincipalRegexp matches AWS service principal names.
@@ -16,3 +17,38 @@ var servicePrincipalRegexp = regexache.MustCompile(`^(?:[0-9a-z-]+\.){1,4}(?:ama
 func IsServicePrincipal(s string) bool {
 	return servicePrincipalRegexp.MatchString(s)
 }
+
+// ServicePrincipalSuffixForPartition returns the DNS suffix of the specified service's service principal in the specified partition.
+// SPN region unique taken from
+// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
+func ServicePrincipalSuffixForPartition(service string, partition endpoints.Partition) string {
+	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
+		switch partitionID {
+		case endpoints.AwsIsoPartitionID:
+			switch service {
+			case "cloudhsm",
+				"config",
+				"logs",
+				"workspaces":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsIsoBPartitionID:
+			switch service {
+			case "dms",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsCnPartitionID:
+			switch service {
+			case "codedeploy",
+				"elasticmapreduce",
+				"logs",
+				"ec2",
+				"s3":
+				return partition.DNSSuffix()
+			}
+		}
+	}
+
+	return "amazonaws.com"
+}
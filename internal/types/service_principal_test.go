@@ -3,7 +3,11 @@
 
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
 
 func TestIsServicePrincipal(t *testing.T) {
 	t.Parallel()
@@ -62,3 +66,30 @@ func TestIsServicePrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestServicePrincipalSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	partition := func(regionID string) endpoints.Partition {
+		p, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), regionID)
+		return p
+	}
+
+	for _, tc := range []struct {
+		service   string
+		partition endpoints.Partition
+		want      string
+	}{
+		{"logs", partition(endpoints.UsWest2RegionID), "amazonaws.com"},
+		{"logs", partition(endpoints.CnNorth1RegionID), "amazonaws.com.cn"},
+		{"lambda", partition(endpoints.CnNorth1RegionID), "amazonaws.com"},
+		{"logs", partition(endpoints.UsGovWest1RegionID), "amazonaws.com"},
+		{"config", partition(endpoints.UsIsoEast1RegionID), "c2s.ic.gov"},
+		{"dms", partition(endpoints.UsIsobEast1RegionID), "sc2s.sgov.gov"},
+		{"", partition(endpoints.CnNorth1RegionID), "amazonaws.com"},
+	} {
+		if got := ServicePrincipalSuffixForPartition(tc.service, tc.partition); got != tc.want {
+			t.Errorf("ServicePrincipalSuffixForPartition(%q, %q) = %q, want %q", tc.service, tc.partition.ID(), got, tc.want)
+		}
+	}
+}
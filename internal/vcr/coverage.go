@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+const coverageReportExtension = ".coverage.json"
+
+// CoverageReport describes how the requests made by a test matched the interactions recorded in its cassette.
+type CoverageReport struct {
+	TestName string `json:"test_name"`
+	Cassette string `json:"cassette"`
+	Failed   bool   `json:"failed"`
+	// Replayed is the number of recorded interactions that matched a request.
+	Replayed int `json:"replayed"`
+	// Unmatched are requests for which no recorded interaction was found.
+	Unmatched []CoverageRequest `json:"unmatched,omitempty"`
+	// Unused are recorded interactions that no request matched.
+	Unused []CoverageRequest `json:"unused,omitempty"`
+
+	mu    sync.Mutex
+	scrub func(string) string
+}
+
+// CoverageRequest identifies a request or a recorded interaction's request.
+type CoverageRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+func (r CoverageRequest) String() string {
+	if r.Body == "" {
+		return r.Method + " " + r.URL
+	}
+
+	return r.Method + " " + r.URL + " " + r.Body
+}
+
+// NewCoverageReport returns an empty coverage report for a test.
+// Unmatched requests are passed through scrub so that they can be compared with recorded interactions.
+func NewCoverageReport(testName, cassette string, scrub func(string) string) *CoverageReport {
+	if scrub == nil {
+		scrub = func(v string) string { return v }
+	}
+
+	return &CoverageReport{
+		TestName: testName,
+		Cassette: cassette,
+		scrub:    scrub,
+	}
+}
+
+// IsStale reports whether the cassette has unused interactions or the test made requests that were not recorded.
+func (r *CoverageReport) IsStale() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.Unmatched) > 0 || len(r.Unused) > 0
+}
+
+// RecorderStopHook is a go-vcr hook, run for each recorded interaction when the recorder is stopped, that records whether the interaction was replayed.
+func (r *CoverageReport) RecorderStopHook(i *cassette.Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i.WasReplayed() {
+		r.Replayed++
+	} else {
+		r.Unused = append(r.Unused, CoverageRequest{
+			Method: i.Request.Method,
+			URL:    i.Request.URL,
+			Body:   i.Request.Body,
+		})
+	}
+
+	return nil
+}
+
+func (r *CoverageReport) addUnmatched(req *http.Request, body string) {
+	v := CoverageRequest{
+		Method: req.Method,
+		URL:    r.scrub(req.URL.String()),
+		Body:   r.scrub(body),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The same request may be retried.
+	if !slices.Contains(r.Unmatched, v) {
+		r.Unmatched = append(r.Unmatched, v)
+	}
+}
+
+// Diff returns the report's unused interactions and unmatched requests in a diff-like format.
+// Lines prefixed with "-" are recorded interactions that were not replayed and lines prefixed with "+" are requests that were not recorded.
+func (r *CoverageReport) Diff() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n", r.Cassette)
+	fmt.Fprintf(&sb, "+++ %s\n", r.TestName)
+	for _, v := range r.Unused {
+		fmt.Fprintf(&sb, "- %s\n", v)
+	}
+	for _, v := range r.Unmatched {
+		fmt.Fprintf(&sb, "+ %s\n", v)
+	}
+
+	return sb.String()
+}
+
+// WriteFile writes the report as JSON to the specified directory.
+func (r *CoverageReport) WriteFile(dir string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil { //nolint:mnd
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, strings.ReplaceAll(r.TestName, "/", "_")+coverageReportExtension), b, 0644) //nolint:mnd
+}
+
+// ReadCoverageReports reads the coverage reports in the specified directory, ordered by test name.
+func ReadCoverageReports(dir string) ([]*CoverageReport, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+coverageReportExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []*CoverageReport
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var report CoverageReport
+		if err := json.Unmarshal(b, &report); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		reports = append(reports, &report)
+	}
+
+	slices.SortFunc(reports, func(a, b *CoverageReport) int {
+		return strings.Compare(a.TestName, b.TestName)
+	})
+
+	return reports, nil
+}
+
+// WriteCoverageSummary writes the diffs of the stale reports followed by totals across all reports.
+func WriteCoverageSummary(w io.Writer, reports []*CoverageReport) error {
+	var stale, replayed, unmatched, unused int
+	for _, report := range reports {
+		replayed += report.Replayed
+		unmatched += len(report.Unmatched)
+		unused += len(report.Unused)
+
+		if !report.IsStale() {
+			continue
+		}
+
+		stale++
+		if _, err := io.WriteString(w, report.Diff()+"\n"); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%d tests, %d stale cassettes: %d interactions replayed, %d unused, %d requests unmatched\n", len(reports), stale, replayed, unused, unmatched)
+
+	return err
+}
+
+// CoverageTransport is a go-vcr recorder that records requests for which no interaction is found in a coverage report.
+type CoverageTransport struct {
+	*recorder.Recorder
+	Report *CoverageReport
+}
+
+func (t *CoverageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		body = string(b)
+	}
+
+	resp, err := t.Recorder.RoundTrip(req)
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Report.addUnmatched(req, body)
+	}
+
+	return resp, err
+}
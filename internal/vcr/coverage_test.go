@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr_test
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestCoverageTransport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cassetteName := filepath.Join(dir, "TestAccExample_basic")
+
+	// Record a cassette with two interactions.
+	r, err := recorder.New(cassetteName,
+		recorder.WithMode(recorder.ModeRecordOnly),
+		recorder.WithRealTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+		})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{"https://example.com/used", "https://example.com/unused"} {
+		resp, err := r.GetDefaultClient().Get(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	cassetteFile := cassetteName + ".yaml"
+
+	report := vcr.NewCoverageReport("TestAccExample_basic", cassetteFile, strings.ToUpper)
+	r, err = recorder.New(cassetteName,
+		recorder.WithMode(recorder.ModeReplayOnly),
+		recorder.WithHook(report.RecorderStopHook, recorder.OnRecorderStopHook),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: &vcr.CoverageTransport{Recorder: r, Report: report}}
+
+	resp, err := client.Get("https://example.com/used")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	// Retried requests are reported once.
+	for range 2 {
+		if _, err := client.Get("https://example.com/new"); !errors.Is(err, cassette.ErrInteractionNotFound) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.IsStale() {
+		t.Error("IsStale() = false, want true")
+	}
+	if got, want := report.Replayed, 1; got != want {
+		t.Errorf("Replayed = %d, want %d", got, want)
+	}
+
+	wantDiff := `--- ` + cassetteFile + `
++++ TestAccExample_basic
+- GET https://example.com/unused
++ GET HTTPS://EXAMPLE.COM/NEW
+`
+	if diff := cmp.Diff(report.Diff(), wantDiff); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	reportDir := filepath.Join(dir, "reports")
+	if err := report.WriteFile(reportDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := vcr.NewCoverageReport("TestAccExample_disappears", "", nil).WriteFile(reportDir); err != nil {
+		t.Fatal(err)
+	}
+
+	reports, err := vcr.ReadCoverageReports(reportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sb strings.Builder
+	if err := vcr.WriteCoverageSummary(&sb, reports); err != nil {
+		t.Fatal(err)
+	}
+
+	wantSummary := wantDiff + "\n2 tests, 1 stale cassettes: 1 interactions replayed, 1 unused, 1 requests unmatched\n"
+	if diff := cmp.Diff(sb.String(), wantSummary); diff != "" {
+		t.Errorf("unexpected summary (+wanted, -got): %s", diff)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
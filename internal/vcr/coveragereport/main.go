@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// coveragereport summarizes the VCR cassette coverage reports written to
+// VCR_REPORT_PATH by a REPLAY_ONLY acceptance test run.
+//
+// It exits with a non-zero status if any cassette is stale.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+)
+
+func main() {
+	log.SetFlags(0)
+
+	path := flag.String("path", vcr.ReportPath(), "directory containing coverage reports")
+	flag.Parse()
+
+	if *path == "" {
+		log.Fatal("no coverage report directory: set -path or VCR_REPORT_PATH")
+	}
+
+	reports, err := vcr.ReadCoverageReports(*path)
+	if err != nil {
+		log.Fatalf("reading coverage reports: %s", err)
+	}
+
+	if err := vcr.WriteCoverageSummary(os.Stdout, reports); err != nil {
+		log.Fatalf("writing coverage summary: %s", err)
+	}
+
+	for _, report := range reports {
+		if report.IsStale() {
+			fmt.Fprintln(os.Stderr, "stale cassettes found, re-record the tests listed above in RECORD_ONLY mode")
+			os.Exit(1)
+		}
+	}
+}
@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	envVarVCRMode = "VCR_MODE"
-	envVarVCRPath = "VCR_PATH"
+	envVarVCRMode       = "VCR_MODE"
+	envVarVCRPath       = "VCR_PATH"
+	envVarVCRReportPath = "VCR_REPORT_PATH"
 
 	vcrModeRecordOnly = "RECORD_ONLY"
 	vcrModeReplayOnly = "REPLAY_ONLY"
@@ -42,3 +43,10 @@ func Mode() (recorder.Mode, error) {
 func Path() string {
 	return os.Getenv(envVarVCRPath)
 }
+
+// ReportPath returns the directory in which cassette coverage reports should be stored
+//
+// Coverage reports are only written when replaying and VCR_REPORT_PATH is set to a non-empty value.
+func ReportPath() string {
+	return os.Getenv(envVarVCRReportPath)
+}
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"hash/crc32"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// Canonical placeholders written to recorded interactions in place of environment-specific values.
+const (
+	AccountIDPlaceholder       = "123456789012"
+	RegionPlaceholder          = "vcr-region-1"
+	AlternateRegionPlaceholder = "vcr-region-2"
+	ThirdRegionPlaceholder     = "vcr-region-3"
+
+	redactedPlaceholder = "REDACTED"
+)
+
+// ScrubRule rewrites values in recorded HTTP interactions.
+//
+// Scrub replaces values with canonical placeholders before an interaction is saved.
+// Unscrub replaces placeholders with values for the current environment before a recorded response is replayed.
+// Rules that redact secrets cannot be reversed and return their input from Unscrub.
+type ScrubRule interface {
+	Scrub(string) string
+	Unscrub(string) string
+}
+
+// Scrubber applies an ordered set of ScrubRules to recorded HTTP interactions.
+// Rules may be added while a recorder is in use, for example once the provider has determined the AWS account ID.
+type Scrubber struct {
+	mu    sync.RWMutex
+	rules []ScrubRule
+}
+
+func NewScrubber(rules ...ScrubRule) *Scrubber {
+	return &Scrubber{
+		rules: rules,
+	}
+}
+
+// AddRule appends a rule to the scrubber. A nil rule is ignored.
+func (s *Scrubber) AddRule(rule ScrubRule) {
+	if rule == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rules = append(s.rules, rule)
+}
+
+// Scrub applies each rule's Scrub, in order.
+func (s *Scrubber) Scrub(v string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, rule := range s.rules {
+		v = rule.Scrub(v)
+	}
+
+	return v
+}
+
+// Unscrub applies each rule's Unscrub, in reverse order.
+func (s *Scrubber) Unscrub(v string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i := len(s.rules) - 1; i >= 0; i-- {
+		v = s.rules[i].Unscrub(v)
+	}
+
+	return v
+}
+
+// ScrubInteraction is a go-vcr hook that scrubs a recorded request and response.
+func (s *Scrubber) ScrubInteraction(i *cassette.Interaction) error {
+	i.Request.URL = s.Scrub(i.Request.URL)
+	i.Request.Host = s.Scrub(i.Request.Host)
+	i.Request.RequestURI = s.Scrub(i.Request.RequestURI)
+	i.Request.Form = transformValues(i.Request.Form, s.Scrub)
+	transformHeaders(i.Request.Headers, s.Scrub)
+	if body, ok := transformBody(i.Request.Body, s.Scrub); ok {
+		i.Request.Body = body
+		i.Request.ContentLength = updatedContentLength(i.Request.ContentLength, body)
+		updateBodyHeaders(i.Request.Headers, body)
+	}
+
+	transformHeaders(i.Response.Headers, s.Scrub)
+	if body, ok := transformBody(i.Response.Body, s.Scrub); ok {
+		i.Response.Body = body
+		i.Response.ContentLength = updatedContentLength(i.Response.ContentLength, body)
+		updateBodyHeaders(i.Response.Headers, body)
+	}
+
+	return nil
+}
+
+// UnscrubResponse is a go-vcr hook that restores environment-specific values in a recorded response before it is replayed.
+func (s *Scrubber) UnscrubResponse(i *cassette.Interaction) error {
+	transformHeaders(i.Response.Headers, s.Unscrub)
+	if body, ok := transformBody(i.Response.Body, s.Unscrub); ok {
+		i.Response.Body = body
+		i.Response.ContentLength = updatedContentLength(i.Response.ContentLength, body)
+		updateBodyHeaders(i.Response.Headers, body)
+	}
+
+	return nil
+}
+
+// AccountIDRule returns a rule that replaces an AWS account ID with a placeholder.
+// An account ID is only replaced where it is not part of a longer run of digits.
+// Returns nil if either value is empty.
+func AccountIDRule(accountID, placeholder string) ScrubRule {
+	if accountID == "" || placeholder == "" {
+		return nil
+	}
+
+	return &substitutionRule{
+		value:       accountID,
+		placeholder: placeholder,
+		before:      isASCIIDigit,
+		after:       isASCIIDigit,
+	}
+}
+
+// RegionRule returns a rule that replaces an AWS Region name with a placeholder.
+// Availability Zone names, for example `us-west-2a`, are rewritten along with the Region.
+// Returns nil if either value is empty.
+func RegionRule(region, placeholder string) ScrubRule {
+	if region == "" || placeholder == "" {
+		return nil
+	}
+
+	return &substitutionRule{
+		value:       region,
+		placeholder: placeholder,
+		before:      func(b byte) bool { return isASCIIDigit(b) || ('a' <= b && b <= 'z') },
+		after:       isASCIIDigit,
+	}
+}
+
+// RedactionRule returns a rule that replaces matches of re with replacement, which may reference submatches.
+// Redaction cannot be reversed.
+func RedactionRule(re *regexp.Regexp, replacement string) ScrubRule {
+	return &redactionRule{
+		re:          re,
+		replacement: replacement,
+	}
+}
+
+// SensitiveValueRules returns rules that redact credentials and request signatures.
+func SensitiveValueRules() []ScrubRule {
+	return []ScrubRule{
+		// Access key IDs keep their prefix so that long-term and temporary credentials remain distinguishable.
+		RedactionRule(regexache.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`), "${1}IOSFODNN7EXAMPLE"),
+		RedactionRule(regexache.MustCompile(`(<(?:SecretAccessKey|SessionToken)>)[^<]*(</)`), "${1}"+redactedPlaceholder+"${2}"),
+		RedactionRule(regexache.MustCompile(`("(?:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`), "${1}"+redactedPlaceholder+"${2}"),
+		// Presigned URL query string parameters.
+		RedactionRule(regexache.MustCompile(`(X-Amz-Signature=)[0-9a-f]+`), "${1}"+redactedPlaceholder),
+		RedactionRule(regexache.MustCompile(`(X-Amz-Security-Token=)[^&"'<\s]+`), "${1}"+redactedPlaceholder),
+	}
+}
+
+type substitutionRule struct {
+	value, placeholder string
+	// before and after report whether a byte adjacent to a match means that the match is part of a longer token.
+	before, after func(byte) bool
+}
+
+func (r *substitutionRule) Scrub(v string) string {
+	return replaceBounded(v, r.value, r.placeholder, r.before, r.after)
+}
+
+func (r *substitutionRule) Unscrub(v string) string {
+	return replaceBounded(v, r.placeholder, r.value, r.before, r.after)
+}
+
+type redactionRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+func (r *redactionRule) Scrub(v string) string {
+	return r.re.ReplaceAllString(v, r.replacement)
+}
+
+func (r *redactionRule) Unscrub(v string) string {
+	return v
+}
+
+// replaceBounded replaces each occurrence of old in s with replacement, skipping occurrences that are adjacent to a byte for which before or after returns true.
+func replaceBounded(s, old, replacement string, before, after func(byte) bool) string {
+	if old == replacement || !strings.Contains(s, old) {
+		return s
+	}
+
+	var sb strings.Builder
+	start := 0
+	for {
+		i := strings.Index(s[start:], old)
+		if i < 0 {
+			sb.WriteString(s[start:])
+			break
+		}
+
+		i += start
+		j := i + len(old)
+		if (i > 0 && before(s[i-1])) || (j < len(s) && after(s[j])) {
+			sb.WriteString(s[start:j])
+		} else {
+			sb.WriteString(s[start:i])
+			sb.WriteString(replacement)
+		}
+		start = j
+	}
+
+	return sb.String()
+}
+
+func isASCIIDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func transformHeaders(h http.Header, f func(string) string) {
+	for _, vs := range h {
+		for i, v := range vs {
+			vs[i] = f(v)
+		}
+	}
+}
+
+func transformValues(values url.Values, f func(string) string) url.Values {
+	if values == nil {
+		return nil
+	}
+
+	out := make(url.Values, len(values))
+	for k, vs := range values {
+		k = f(k)
+		for _, v := range vs {
+			out[k] = append(out[k], f(v))
+		}
+	}
+
+	return out
+}
+
+// transformBody applies f to a textual body, returning false if the body is unchanged.
+// Binary bodies are not modified.
+func transformBody(body string, f func(string) string) (string, bool) {
+	if body == "" || !utf8.ValidString(body) {
+		return body, false
+	}
+
+	v := f(body)
+
+	return v, v != body
+}
+
+func updatedContentLength(contentLength int64, body string) int64 {
+	// A negative value indicates that the length is unknown.
+	if contentLength < 0 {
+		return contentLength
+	}
+
+	return int64(len(body))
+}
+
+// updateBodyHeaders updates headers that are derived from a body after the body is modified.
+func updateBodyHeaders(h http.Header, body string) {
+	if h == nil {
+		return
+	}
+
+	if h.Get("Content-Length") != "" {
+		h.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	// Amazon DynamoDB response checksum.
+	if h.Get("X-Amz-Crc32") != "" {
+		h.Set("X-Amz-Crc32", strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(body))), 10))
+	}
+
+	// Flexible checksums cannot be recomputed without knowing how the client is configured, so they are removed and not validated.
+	for k := range h {
+		if strings.HasPrefix(http.CanonicalHeaderKey(k), "X-Amz-Checksum-") {
+			delete(h, k)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/generate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	createOperation string
+	readOperation   string
+	updateOperation string
+	deleteOperation string
+	tagOperation    string
+	listOperation   string
+)
+
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate a framework resource from AWS SDK for Go v2 operations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return generate.Create(generate.Options{
+			Name:            name,
+			SnakeName:       snakeName,
+			CreateOperation: createOperation,
+			ReadOperation:   readOperation,
+			UpdateOperation: updateOperation,
+			DeleteOperation: deleteOperation,
+			TagOperation:    tagOperation,
+			ListOperation:   listOperation,
+			Force:           force,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(generateCmd)
+	generateCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "if skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	generateCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
+	generateCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+	generateCmd.Flags().StringVar(&createOperation, "create", "", "SDK operation that creates the resource (default Create<Name>)")
+	generateCmd.Flags().StringVar(&readOperation, "read", "", "SDK operation that describes the resource (default Describe<Name> or Get<Name>)")
+	generateCmd.Flags().StringVar(&updateOperation, "update", "", "SDK operation that updates the resource (default Update<Name> or Modify<Name>, if any)")
+	generateCmd.Flags().StringVar(&deleteOperation, "delete", "", "SDK operation that deletes the resource (default Delete<Name>)")
+	generateCmd.Flags().StringVar(&tagOperation, "tag", "", "SDK operation that tags the resource (e.g., TagResource); tags are also generated if the create operation accepts them")
+	generateCmd.Flags().StringVar(&listOperation, "list", "", "SDK operation that lists resources, used by the sweeper (default List<Names> or Describe<Names>, if any)")
+}
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/migrate"
+	"github.com/spf13/cobra"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate an SDKv2 resource to the Plugin Framework",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrate.Create(migrate.Options{
+			Name:  name,
+			Force: force,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.Flags().StringVarP(&name, "name", "n", "", "name of the SDKv2 resource, without the resource function prefix (e.g., FlowLog) or as its resource type (e.g., aws_flow_log)")
+	migrateCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
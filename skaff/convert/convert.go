@@ -5,6 +5,7 @@ package convert
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 	"unicode"
 
@@ -57,3 +58,72 @@ func ToAWSCapitalization(s string) string {
 	// TODO: This is incomplete
 	return strings.ReplaceAll(s, "VPC", "Vpc")
 }
+
+// providerInitialisms maps words as capitalized in AWS API structs to the
+// capitalization used by provider standards
+var providerInitialisms = map[string]string{
+	"Acl":   "ACL",
+	"Acm":   "ACM",
+	"Ami":   "AMI",
+	"Api":   "API",
+	"Arn":   "ARN",
+	"Cidr":  "CIDR",
+	"Dns":   "DNS",
+	"Ebs":   "EBS",
+	"Ec2":   "EC2",
+	"Http":  "HTTP",
+	"Https": "HTTPS",
+	"Iam":   "IAM",
+	"Id":    "ID",
+	"Ip":    "IP",
+	"Json":  "JSON",
+	"Kms":   "KMS",
+	"Sns":   "SNS",
+	"Sqs":   "SQS",
+	"Sse":   "SSE",
+	"Ssl":   "SSL",
+	"Tls":   "TLS",
+	"Ttl":   "TTL",
+	"Uri":   "URI",
+	"Url":   "URL",
+	"Vpc":   "VPC",
+}
+
+// ToProviderCapitalization converts a field name as capitalized in AWS API
+// structs to the capitalization used by provider standards (e.g., KmsKeyId
+// becomes KMSKeyID)
+func ToProviderCapitalization(s string) string {
+	var sb strings.Builder
+
+	for word := range camelCaseWords(s) {
+		// Plurals of initialisms keep a lowercase "s" (e.g., ARNs).
+		if v, ok := providerInitialisms[word]; ok {
+			sb.WriteString(v)
+		} else if v, ok := providerInitialisms[strings.TrimSuffix(word, "s")]; ok && strings.HasSuffix(word, "s") {
+			sb.WriteString(v + "s")
+		} else {
+			sb.WriteString(word)
+		}
+	}
+
+	return sb.String()
+}
+
+// camelCaseWords splits a CamelCase string into words, each starting with
+// an uppercase letter or digit run
+func camelCaseWords(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		start := 0
+		for i := 1; i < len(s); i++ {
+			if unicode.IsUpper(rune(s[i])) && !unicode.IsUpper(rune(s[i-1])) {
+				if !yield(s[start:i]) {
+					return
+				}
+				start = i
+			}
+		}
+		if start < len(s) {
+			yield(s[start:])
+		}
+	}
+}
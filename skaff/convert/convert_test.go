@@ -103,3 +103,42 @@ func TestToAWSCapitalization(t *testing.T) {
 		})
 	}
 }
+
+func TestToProviderCapitalization(t *testing.T) {
+	tests := map[string]struct {
+		s    string
+		want string
+	}{
+		"empty": {
+			s:    "",
+			want: "",
+		},
+		"unchanged": {
+			s:    "ThisWillNotChange",
+			want: "ThisWillNotChange",
+		},
+		"initialisms": {
+			s:    "KmsMasterKeyId",
+			want: "KMSMasterKeyID",
+		},
+		"plural": {
+			s:    "SubnetIds",
+			want: "SubnetIDs",
+		},
+		"already capitalized": {
+			s:    "VPCConfig",
+			want: "VPCConfig",
+		},
+		"prefix of word": {
+			s:    "Identifier",
+			want: "Identifier",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ToProviderCapitalization(tt.s); got != tt.want {
+				t.Errorf("ToProviderCapitalization() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
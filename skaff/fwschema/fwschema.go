@@ -0,0 +1,484 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwschema describes Terraform Plugin Framework resource schemas and
+// their AutoFlex-compatible models, and renders both as Go source.
+package fwschema
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+// Import paths used by rendered source.
+const (
+	ImportFramework      = "github.com/hashicorp/terraform-provider-aws/internal/framework"
+	ImportFWTypes        = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	ImportJSONTypes      = "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	ImportListValidator  = "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	ImportNames          = "github.com/hashicorp/terraform-provider-aws/names"
+	ImportPath           = "github.com/hashicorp/terraform-plugin-framework/path"
+	ImportPlanModifier   = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	ImportSchema         = "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	ImportSetValidator   = "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	ImportTags           = "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	ImportTimeouts       = "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	ImportTimeTypes      = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	ImportTypes          = "github.com/hashicorp/terraform-plugin-framework/types"
+	ImportValidator      = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	ImportValidators     = "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	importSchemaBase     = "github.com/hashicorp/terraform-plugin-framework/resource/schema/"
+	importValidatorsBase = "github.com/hashicorp/terraform-plugin-framework-validators/"
+)
+
+// Imports is a set of import paths, keyed by path, with optional aliases.
+type Imports map[string]string
+
+var importAliases = map[string]string{
+	ImportFWTypes:    "fwtypes",
+	ImportTags:       "tftags",
+	ImportValidators: "fwvalidators",
+}
+
+// exprImports are the import paths of package qualifiers used in schema expressions.
+var exprImports = map[string]string{
+	"booldefault.":      importSchemaBase + "booldefault",
+	"boolvalidator.":    importValidatorsBase + "boolvalidator",
+	"float64default.":   importSchemaBase + "float64default",
+	"int64default.":     importSchemaBase + "int64default",
+	"stringdefault.":    importSchemaBase + "stringdefault",
+	"float64validator.": importValidatorsBase + "float64validator",
+	"int64validator.":   importValidatorsBase + "int64validator",
+	"listvalidator.":    ImportListValidator,
+	"mapvalidator.":     importValidatorsBase + "mapvalidator",
+	"setvalidator.":     ImportSetValidator,
+	"stringvalidator.":  importValidatorsBase + "stringvalidator",
+	"fwtypes.":          ImportFWTypes,
+	"fwvalidators.":     ImportValidators,
+	"framework.":        ImportFramework,
+	"tftags.":           ImportTags,
+	"timeouts.":         ImportTimeouts,
+	"names.":            ImportNames,
+	"path.":             ImportPath,
+}
+
+// AddExpr adds the imports of the package qualifiers used in a schema expression (e.g. a default or validator).
+func (i Imports) AddExpr(expr string) {
+	for qualifier, path := range exprImports {
+		if containsQualifier(expr, qualifier) {
+			i.Add(path)
+		}
+	}
+}
+
+// containsQualifier reports whether expr uses the package qualifier, ignoring longer identifiers ending in it.
+func containsQualifier(expr, qualifier string) bool {
+	for i := strings.Index(expr, qualifier); i >= 0; {
+		if i == 0 || !isIdentifierByte(expr[i-1]) {
+			return true
+		}
+		j := strings.Index(expr[i+1:], qualifier)
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+
+	return false
+}
+
+func isIdentifierByte(b byte) bool {
+	return b == '_' || b == '.' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// Add adds an import path, using the provider's conventional alias if it has one.
+func (i Imports) Add(path string) {
+	i[path] = importAliases[path]
+}
+
+// Specs returns the import specs in sorted order (e.g. `fwtypes "github.com/..."`).
+func (i Imports) Specs() []string {
+	return slices.Collect(func(yield func(string) bool) {
+		for _, path := range slices.Sorted(maps.Keys(i)) {
+			spec := fmt.Sprintf("%q", path)
+			if alias := i[path]; alias != "" {
+				spec = alias + " " + spec
+			}
+			if !yield(spec) {
+				return
+			}
+		}
+	})
+}
+
+// Kind is the kind of a schema attribute.
+type Kind string
+
+const (
+	KindBool    Kind = "Bool"
+	KindFloat32 Kind = "Float32"
+	KindFloat64 Kind = "Float64"
+	KindInt32   Kind = "Int32"
+	KindInt64   Kind = "Int64"
+	KindList    Kind = "List"
+	KindMap     Kind = "Map"
+	KindSet     Kind = "Set"
+	KindString  Kind = "String"
+)
+
+// Type is the type of a schema attribute and its model field.
+type Type struct {
+	Kind        Kind
+	Model       string // Model field type, e.g. types.String.
+	CustomType  string // Schema CustomType expression, if any.
+	ElementType string // Schema ElementType expression, for collections.
+	Imports     []string
+}
+
+// Attribute is a schema attribute.
+type Attribute struct {
+	Name      string // Terraform attribute name, e.g. kms_key_id.
+	FieldName string // Model struct field name, e.g. KMSKeyID.
+	Type      Type
+
+	Required           bool
+	Optional           bool
+	Computed           bool
+	Sensitive          bool
+	RequiresReplace    bool
+	UseStateForUnknown bool
+
+	// Default is a schema Default expression, if any.
+	Default string
+	// Validators are schema validator expressions, e.g. stringvalidator.LengthAtMost(64).
+	Validators []string
+	// DeprecationMessage, if set, marks the attribute as deprecated.
+	DeprecationMessage string
+	// AutoFlex is the model field's autoflex struct tag value, if any.
+	AutoFlex string
+	// Expr, if set, is rendered instead of a schema attribute literal, e.g. tftags.TagsAttribute().
+	Expr string
+	// Comment, if set, is rendered as a line comment before the attribute.
+	Comment string
+	// Nested, if set, describes the model of a nested object attribute's elements.
+	Nested *Block
+}
+
+// Block is a nested block.
+type Block struct {
+	Name      string // Terraform block name.
+	FieldName string // Model struct field name.
+	Model     string // Nested model struct type name, e.g. encryptionConfigurationModel.
+
+	Set             bool
+	MinItems        int
+	MaxItems        int
+	RequiresReplace bool
+	// Validators are schema validator expressions in addition to the size validators.
+	Validators         []string
+	DeprecationMessage string
+
+	AutoFlex string
+
+	// Expr and ModelType, if set, are rendered instead of a nested block literal, e.g. timeouts.Block(...).
+	Expr      string
+	ModelType string
+
+	Comment string
+
+	Attributes []*Attribute
+	Blocks     []*Block
+}
+
+// Schema is a resource schema.
+type Schema struct {
+	Version    int64
+	Attributes []*Attribute
+	Blocks     []*Block
+}
+
+// Sort sorts attributes and blocks by name, recursively.
+func (s *Schema) Sort() {
+	sortAttributesAndBlocks(s.Attributes, s.Blocks)
+}
+
+func sortAttributesAndBlocks(attributes []*Attribute, blocks []*Block) {
+	slices.SortFunc(attributes, func(a, b *Attribute) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(blocks, func(a, b *Block) int { return cmp.Compare(a.Name, b.Name) })
+	for _, b := range blocks {
+		sortAttributesAndBlocks(b.Attributes, b.Blocks)
+	}
+}
+
+// Attribute returns the named top-level attribute, or nil.
+func (s *Schema) Attribute(name string) *Attribute {
+	i := slices.IndexFunc(s.Attributes, func(a *Attribute) bool { return a.Name == name })
+	if i < 0 {
+		return nil
+	}
+
+	return s.Attributes[i]
+}
+
+// RenderSchema returns the schema.Schema literal, recording the imports it uses.
+func (s *Schema) RenderSchema(imports Imports) string {
+	imports.Add(ImportSchema)
+
+	var sb strings.Builder
+	sb.WriteString("schema.Schema{\n")
+	if s.Version > 0 {
+		fmt.Fprintf(&sb, "Version: %d,\n", s.Version)
+	}
+	renderAttributesAndBlocks(&sb, imports, s.Attributes, s.Blocks)
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+func renderAttributesAndBlocks(sb *strings.Builder, imports Imports, attributes []*Attribute, blocks []*Block) {
+	if len(attributes) > 0 {
+		sb.WriteString("Attributes: map[string]schema.Attribute{\n")
+		for _, a := range attributes {
+			renderAttribute(sb, imports, a)
+		}
+		sb.WriteString("},\n")
+	}
+
+	if len(blocks) > 0 {
+		sb.WriteString("Blocks: map[string]schema.Block{\n")
+		for _, b := range blocks {
+			renderBlock(sb, imports, b)
+		}
+		sb.WriteString("},\n")
+	}
+}
+
+func attributeKey(imports Imports, name string) string {
+	key := namesgen.ConstOrQuote(name)
+	if strings.HasPrefix(key, "names.") {
+		imports.Add(ImportNames)
+	}
+
+	return key
+}
+
+func renderAttribute(sb *strings.Builder, imports Imports, a *Attribute) {
+	if a.Comment != "" {
+		fmt.Fprintf(sb, "// %s\n", a.Comment)
+	}
+
+	key := attributeKey(imports, a.Name)
+
+	if a.Expr != "" {
+		imports.AddExpr(a.Expr)
+		fmt.Fprintf(sb, "%s: %s,\n", key, a.Expr)
+		return
+	}
+
+	for _, v := range a.Type.Imports {
+		imports.Add(v)
+	}
+
+	fmt.Fprintf(sb, "%s: schema.%sAttribute{\n", key, a.Type.Kind)
+	if a.Type.CustomType != "" {
+		fmt.Fprintf(sb, "CustomType: %s,\n", a.Type.CustomType)
+	}
+	if a.Type.ElementType != "" {
+		if strings.HasPrefix(a.Type.ElementType, "types.") {
+			imports.Add(ImportTypes)
+		}
+		fmt.Fprintf(sb, "ElementType: %s,\n", a.Type.ElementType)
+	}
+	for _, v := range []struct {
+		name string
+		set  bool
+	}{
+		{"Required", a.Required},
+		{"Optional", a.Optional},
+		{"Computed", a.Computed},
+		{"Sensitive", a.Sensitive},
+	} {
+		if v.set {
+			fmt.Fprintf(sb, "%s: true,\n", v.name)
+		}
+	}
+	if a.DeprecationMessage != "" {
+		fmt.Fprintf(sb, "DeprecationMessage: %q,\n", a.DeprecationMessage)
+	}
+	if a.Default != "" {
+		imports.AddExpr(a.Default)
+		fmt.Fprintf(sb, "Default: %s,\n", a.Default)
+	}
+	renderPlanModifiers(sb, imports, a.Type.Kind, a.RequiresReplace, a.UseStateForUnknown)
+	renderValidators(sb, imports, a.Type.Kind, a.Validators)
+	sb.WriteString("},\n")
+}
+
+func renderValidators(sb *strings.Builder, imports Imports, kind Kind, validators []string) {
+	if len(validators) == 0 {
+		return
+	}
+
+	imports.Add(ImportValidator)
+	fmt.Fprintf(sb, "Validators: []validator.%s{\n", kind)
+	for _, v := range validators {
+		imports.AddExpr(v)
+		fmt.Fprintf(sb, "%s,\n", v)
+	}
+	sb.WriteString("},\n")
+}
+
+func renderPlanModifiers(sb *strings.Builder, imports Imports, kind Kind, requiresReplace, useStateForUnknown bool) {
+	if !requiresReplace && !useStateForUnknown {
+		return
+	}
+
+	pkg := strings.ToLower(string(kind)) + "planmodifier"
+	imports.Add(ImportPlanModifier)
+	imports.Add(importSchemaBase + pkg)
+
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n", kind)
+	if requiresReplace {
+		fmt.Fprintf(sb, "%s.RequiresReplace(),\n", pkg)
+	}
+	if useStateForUnknown {
+		fmt.Fprintf(sb, "%s.UseStateForUnknown(),\n", pkg)
+	}
+	sb.WriteString("},\n")
+}
+
+func renderBlock(sb *strings.Builder, imports Imports, b *Block) {
+	if b.Comment != "" {
+		fmt.Fprintf(sb, "// %s\n", b.Comment)
+	}
+
+	key := attributeKey(imports, b.Name)
+
+	if b.Expr != "" {
+		imports.AddExpr(b.Expr)
+		fmt.Fprintf(sb, "%s: %s,\n", key, b.Expr)
+		return
+	}
+
+	imports.Add(ImportFWTypes)
+
+	kind, validatorPkg, validatorImport := KindList, "listvalidator", ImportListValidator
+	if b.Set {
+		kind, validatorPkg, validatorImport = KindSet, "setvalidator", ImportSetValidator
+	}
+
+	fmt.Fprintf(sb, "%s: schema.%sNestedBlock{\n", key, kind)
+	fmt.Fprintf(sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", kind, b.Model)
+
+	if b.DeprecationMessage != "" {
+		fmt.Fprintf(sb, "DeprecationMessage: %q,\n", b.DeprecationMessage)
+	}
+
+	var validators []string
+	if b.MinItems == 1 {
+		validators = append(validators, validatorPkg+".IsRequired()")
+	} else if b.MinItems > 1 {
+		validators = append(validators, fmt.Sprintf("%s.SizeAtLeast(%d)", validatorPkg, b.MinItems))
+	}
+	if b.MaxItems > 0 {
+		validators = append(validators, fmt.Sprintf("%s.SizeAtMost(%d)", validatorPkg, b.MaxItems))
+	}
+	if len(validators) > 0 {
+		imports.Add(validatorImport)
+	}
+	renderPlanModifiers(sb, imports, kind, b.RequiresReplace, false)
+	renderValidators(sb, imports, kind, append(validators, b.Validators...))
+
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	renderAttributesAndBlocks(sb, imports, b.Attributes, b.Blocks)
+	sb.WriteString("},\n")
+	sb.WriteString("},\n")
+}
+
+// ModelFieldType returns the model field type for the block.
+func (b *Block) ModelFieldType() string {
+	if b.ModelType != "" {
+		return b.ModelType
+	}
+
+	kind := KindList
+	if b.Set {
+		kind = KindSet
+	}
+
+	return fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", kind, b.Model)
+}
+
+// RenderModels returns the root model struct and all nested model structs, recording the imports they use.
+// Nested models shared by several blocks are rendered once.
+func (s *Schema) RenderModels(imports Imports, rootModel string, embeds ...string) string {
+	var sb strings.Builder
+	seen := make(map[string]bool)
+
+	renderModel(&sb, imports, rootModel, embeds, s.Attributes, s.Blocks)
+	renderNestedModels(&sb, imports, seen, s.Attributes, s.Blocks)
+
+	return sb.String()
+}
+
+func renderNestedModels(sb *strings.Builder, imports Imports, seen map[string]bool, attributes []*Attribute, blocks []*Block) {
+	for _, a := range attributes {
+		if a.Nested != nil {
+			blocks = append(slices.Clone(blocks), a.Nested)
+		}
+	}
+
+	for _, b := range blocks {
+		if b.Expr != "" || seen[b.Model] {
+			continue
+		}
+		seen[b.Model] = true
+
+		sb.WriteString("\n")
+		renderModel(sb, imports, b.Model, nil, b.Attributes, b.Blocks)
+		renderNestedModels(sb, imports, seen, b.Attributes, b.Blocks)
+	}
+}
+
+type modelField struct {
+	name, typ, tag string
+}
+
+func renderModel(sb *strings.Builder, imports Imports, name string, embeds []string, attributes []*Attribute, blocks []*Block) {
+	var fields []modelField
+	for _, a := range attributes {
+		for _, v := range a.Type.Imports {
+			imports.Add(v)
+		}
+		fields = append(fields, modelField{a.FieldName, a.Type.Model, structTag(a.Name, a.AutoFlex)})
+	}
+	for _, b := range blocks {
+		if b.Expr == "" {
+			imports.Add(ImportFWTypes)
+		}
+		fields = append(fields, modelField{b.FieldName, b.ModelFieldType(), structTag(b.Name, b.AutoFlex)})
+	}
+	slices.SortFunc(fields, func(a, b modelField) int { return cmp.Compare(a.name, b.name) })
+
+	fmt.Fprintf(sb, "type %s struct {\n", name)
+	for _, v := range embeds {
+		fmt.Fprintf(sb, "%s\n", v)
+	}
+	for _, f := range fields {
+		fmt.Fprintf(sb, "%s %s %s\n", f.name, f.typ, f.tag)
+	}
+	sb.WriteString("}\n")
+}
+
+func structTag(name, autoflex string) string {
+	if autoflex == "" {
+		return fmt.Sprintf("`tfsdk:%q`", name)
+	}
+
+	return fmt.Sprintf("`tfsdk:%q autoflex:%q`", name, autoflex)
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// GroupedSpecs returns the import specs with standard library imports first,
+// separated from the rest by an empty spec.
+func (i Imports) GroupedSpecs() []string {
+	var std, other []string
+	for _, v := range i.Specs() {
+		importPath, _ := strconv.Unquote(v[strings.Index(v, `"`):])
+		if first, _, _ := strings.Cut(importPath, "/"); strings.Contains(first, ".") {
+			other = append(other, v)
+		} else {
+			std = append(std, v)
+		}
+	}
+
+	return slices.Concat(std, []string{""}, other)
+}
+
+// Format removes unused imports from generated Go source and formats it.
+func Format(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, spec := range slices.Clone(f.Imports) {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !astutil.UsesImport(f, importPath) {
+			astutil.DeleteNamedImport(fset, f, importName(spec), importPath)
+		}
+	}
+	ast.SortImports(fset, f)
+
+	var buffer bytes.Buffer
+	if err := format.Node(&buffer, fset, f); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	return ""
+}
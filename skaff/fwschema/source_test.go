@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"testing"
+)
+
+func TestFormatSource(t *testing.T) {
+	src := `package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+func f() string {
+return fmt.Sprint(1)
+}
+`
+	expected := `package example
+
+import (
+	"fmt"
+)
+
+func f() string {
+	return fmt.Sprint(1)
+}
+`
+
+	got, err := Format("example.go", []byte(src))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package generate generates a Plugin Framework resource from the AWS SDK for
+// Go v2 operations that create, read, update and delete it.
+package generate
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+//go:embed resource.gtpl
+var resourceTmpl string
+
+//go:embed resourcetest.gtpl
+var resourceTestTmpl string
+
+// Options are the options for generating a resource.
+// Operation names that are not set default to the conventional names for the resource
+// (e.g., CreateTable, DescribeTable or GetTable, UpdateTable, DeleteTable and ListTables).
+type Options struct {
+	Name      string // e.g. Table.
+	SnakeName string // e.g. table.
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+	TagOperation    string
+	ListOperation   string
+
+	Force bool
+}
+
+type TemplateData struct {
+	Resource             string
+	ResourceAWS          string
+	ResourceLowerCamel   string
+	ResourceSnake        string
+	HumanResourceName    string
+	ProviderResourceName string
+	HumanFriendlyService string
+	SDKPackage           string
+	ServicePackage       string
+	Service              string
+
+	Imports []string
+	Schema  string
+	Models  string
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	ID                     identifier
+	Identity               string
+	ImportStateIDAttribute string
+	ExistsType             string
+
+	CreateOutputField string
+	CreateIDExpr      string
+	ClientToken       string
+	UpdateClientToken string
+	UpdateIDField     string // Set explicitly when AutoFlex cannot populate it from the model.
+	DeleteIDField     string
+
+	IncludeTags             bool
+	TagsInCreate            bool
+	TagsIdentifierAttribute string
+
+	ReadShape       string // e.g. *awstypes.TableDescription.
+	ReadShapeElem   string // e.g. awstypes.TableDescription.
+	ReadOutputField string
+	ReadOutputList  bool
+	NotFoundError   string
+
+	Status       *status
+	CreateWaiter bool
+	UpdateWaiter bool
+	DeleteWaiter bool
+	Timeouts     bool
+
+	Sweeper *sweeper
+
+	TestConfig         string
+	TestConfigUsesName bool
+}
+
+func Create(opts Options) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if opts.Name == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if opts.Name == strings.ToLower(opts.Name) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if opts.SnakeName != "" && opts.SnakeName != strings.ToLower(opts.SnakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	snakeName := opts.SnakeName
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(opts.Name)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	pkg, err := sdk.Load(service.GoV2Package())
+	if err != nil {
+		return err
+	}
+
+	resourceAWS := convert.ToAWSCapitalization(opts.Name)
+	ops, err := resolveOperations(pkg, opts, resourceAWS)
+	if err != nil {
+		return err
+	}
+
+	templateData := TemplateData{
+		Resource:             opts.Name,
+		ResourceAWS:          resourceAWS,
+		ResourceLowerCamel:   convert.ToLowercasePrefix(opts.Name),
+		ResourceSnake:        snakeName,
+		HumanResourceName:    convert.ToHumanResName(opts.Name),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		HumanFriendlyService: service.HumanFriendly(),
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		CreateOperation:      ops.create.Name,
+		ReadOperation:        ops.read.Name,
+		DeleteOperation:      ops.delete.Name,
+	}
+	if ops.update != nil {
+		templateData.UpdateOperation = ops.update.Name
+	}
+
+	var r report
+	s, err := analyze(&templateData, pkg, ops, opts.TagOperation != "" || ops.create.Input.Field("Tags") != nil, &r)
+	if err != nil {
+		return err
+	}
+
+	imports := make(fwschema.Imports)
+	templateData.Schema = s.RenderSchema(imports)
+	templateData.Models = s.RenderModels(imports, templateData.ResourceLowerCamel+"ResourceModel", "framework.WithRegionModel")
+	templateData.Imports = resourceImports(imports, templateData.SDKPackage)
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeTemplate("genres", f, resourceTmpl, opts.Force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("genrestest", tf, resourceTestTmpl, opts.Force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	r.todo("add Resource%[1]s = new%[1]sResource and Find%[1]sBy%[2]s = find%[1]sBy%[2]s to exports_test.go", templateData.Resource, templateData.ID.FieldName)
+	if sw := templateData.Sweeper; sw != nil {
+		r.todo("register the sweeper in sweep.go: awsv2.Register(%q, %s)", templateData.ProviderResourceName, sw.Function)
+	}
+	r.todo("add the resource documentation (skaff resource generates a template)")
+
+	fmt.Printf("Generated %s and %s from %s\n", f, tf, strings.Join(operationNames(ops), ", "))
+	r.write(os.Stdout)
+
+	return nil
+}
+
+// resolveOperations looks up the resource's operations, defaulting to conventional names.
+func resolveOperations(pkg *sdk.Package, opts Options, resourceAWS string) (operations, error) {
+	var ops operations
+	var err error
+
+	if ops.create, err = resolveOperation(pkg, opts.CreateOperation, true, "Create"+resourceAWS); err != nil {
+		return ops, err
+	}
+	if ops.read, err = resolveOperation(pkg, opts.ReadOperation, true, "Describe"+resourceAWS, "Get"+resourceAWS); err != nil {
+		return ops, err
+	}
+	if ops.update, err = resolveOperation(pkg, opts.UpdateOperation, false, "Update"+resourceAWS, "Modify"+resourceAWS); err != nil {
+		return ops, err
+	}
+	if ops.delete, err = resolveOperation(pkg, opts.DeleteOperation, true, "Delete"+resourceAWS); err != nil {
+		return ops, err
+	}
+	if ops.list, err = resolveOperation(pkg, opts.ListOperation, false, "List"+plural(resourceAWS), "Describe"+plural(resourceAWS)); err != nil {
+		return ops, err
+	}
+	if opts.TagOperation != "" && !pkg.HasOperation(opts.TagOperation) {
+		return ops, fmt.Errorf("%s: operation %s not found", pkg.Name, opts.TagOperation)
+	}
+
+	return ops, nil
+}
+
+// plural returns the plural form of an SDK resource name (e.g., ScheduledQuery becomes ScheduledQueries).
+func plural(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ay") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	default:
+		return name + "s"
+	}
+}
+
+// resolveOperation returns the named operation or, if no name is given, the first of the candidates that exists.
+func resolveOperation(pkg *sdk.Package, name string, required bool, candidates ...string) (*sdk.Operation, error) {
+	if name != "" {
+		return pkg.Operation(name)
+	}
+
+	for _, v := range candidates {
+		if pkg.HasOperation(v) {
+			return pkg.Operation(v)
+		}
+	}
+
+	if required {
+		return nil, fmt.Errorf("%s: none of operations %s found; name the operation explicitly", pkg.Name, strings.Join(candidates, ", "))
+	}
+
+	return nil, nil
+}
+
+func operationNames(ops operations) []string {
+	var names []string
+	for _, v := range []*sdk.Operation{ops.create, ops.read, ops.update, ops.delete, ops.list} {
+		if v != nil {
+			names = append(names, v.Name)
+		}
+	}
+
+	return names
+}
+
+// resourceImports returns the import specs for the resource source: the schema's imports and
+// every package the template may use. Unused imports are removed when the source is formatted.
+func resourceImports(imports fwschema.Imports, sdkPackage string) []string {
+	for _, v := range []string{
+		"context",
+		"errors",
+		"time",
+		"github.com/YakDriver/smarterr",
+		"github.com/aws/aws-sdk-go-v2/aws",
+		"github.com/aws/aws-sdk-go-v2/service/" + sdkPackage,
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-provider-aws/internal/conns",
+		"github.com/hashicorp/terraform-provider-aws/internal/enum",
+		"github.com/hashicorp/terraform-provider-aws/internal/errs",
+		"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag",
+		"github.com/hashicorp/terraform-provider-aws/internal/framework/flex",
+		"github.com/hashicorp/terraform-provider-aws/internal/retry",
+		"github.com/hashicorp/terraform-provider-aws/internal/smerr",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource",
+		fwschema.ImportFramework,
+		fwschema.ImportNames,
+		fwschema.ImportTimeouts,
+		fwschema.ImportTags,
+		fwschema.ImportFWTypes,
+	} {
+		imports.Add(v)
+	}
+	imports["github.com/aws/aws-sdk-go-v2/service/"+sdkPackage+"/types"] = "awstypes"
+	imports["github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"] = "sdkid"
+	imports["github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"] = "sweepfw"
+
+	return imports.GroupedSpecs()
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := fwschema.Format(filename, buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return write(filename, force, bytes.NewReader(contents))
+}
+
+func write(filename string, force bool, reader io.Reader) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	if _, err := f.ReadFrom(reader); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+func TestPlural(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "Table",
+			Expected: "Tables",
+		},
+		{
+			TestName: "y",
+			Input:    "ScheduledQuery",
+			Expected: "ScheduledQueries",
+		},
+		{
+			TestName: "vowel y",
+			Input:    "APIKey",
+			Expected: "APIKeys",
+		},
+		{
+			TestName: "s",
+			Input:    "IPAddress",
+			Expected: "IPAddresses",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := plural(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	// The SDK package is resolved against the provider module.
+	t.Chdir("../../internal/service/dynamodb")
+
+	pkg, err := sdk.Load("dynamodb")
+	if err != nil {
+		t.Fatalf("loading SDK package: %s", err)
+	}
+
+	ops, err := resolveOperations(pkg, Options{}, "Table")
+	if err != nil {
+		t.Fatalf("resolving operations: %s", err)
+	}
+
+	td := TemplateData{
+		Resource:    "Table",
+		ResourceAWS: "Table",
+	}
+	var r report
+	s, err := analyze(&td, pkg, ops, true, &r)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := td.ID.FieldName, "Name"; got != expected {
+		t.Errorf("ID.FieldName: got %s, expected %s", got, expected)
+	}
+	if got, expected := td.ID.InputField, "TableName"; got != expected {
+		t.Errorf("ID.InputField: got %s, expected %s", got, expected)
+	}
+	if got, expected := td.ReadShape, "*awstypes.TableDescription"; got != expected {
+		t.Errorf("ReadShape: got %s, expected %s", got, expected)
+	}
+	if td.Status == nil {
+		t.Fatal("no status")
+	}
+	if got, expected := td.Status.Ready, []string{"awstypes.TableStatusActive"}; !slices.Equal(got, expected) {
+		t.Errorf("Status.Ready: got %v, expected %v", got, expected)
+	}
+	if !td.CreateWaiter || !td.UpdateWaiter || !td.DeleteWaiter {
+		t.Errorf("waiters: got %t, %t, %t, expected all", td.CreateWaiter, td.UpdateWaiter, td.DeleteWaiter)
+	}
+	if td.Sweeper == nil || td.Sweeper.Operation != "ListTables" {
+		t.Errorf("Sweeper: got %v, expected ListTables", td.Sweeper)
+	}
+
+	for _, name := range []string{"arn", "name", "billing_mode", "table_class", "tags", "tags_all"} {
+		if s.Attribute(name) == nil {
+			t.Errorf("no %s attribute", name)
+		}
+	}
+	if a := s.Attribute("name"); a != nil && !a.Required {
+		t.Error("name is not required")
+	}
+
+	imports := make(fwschema.Imports)
+	if schema := s.RenderSchema(imports); !strings.Contains(schema, `"key_schema": schema.ListNestedBlock{`) {
+		t.Errorf("key_schema is not a list nested block:\n%s", schema)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"fmt"
+	"io"
+)
+
+// report records the SDK constructs that could not be generated and the
+// follow-up work left to the developer.
+type report struct {
+	skips []string
+	todos []string
+}
+
+func (r *report) skipped(path, reason string) {
+	r.skips = append(r.skips, fmt.Sprintf("%s: %s", path, reason))
+}
+
+func (r *report) todo(format string, a ...any) {
+	r.todos = append(r.todos, fmt.Sprintf(format, a...))
+}
+
+func (r *report) write(w io.Writer) {
+	if len(r.skips) > 0 {
+		fmt.Fprintln(w, "Not generated:")
+		for _, v := range r.skips {
+			fmt.Fprintf(w, "  - %s\n", v)
+		}
+	}
+
+	if len(r.todos) > 0 {
+		fmt.Fprintln(w, "To do:")
+		for _, v := range r.todos {
+			fmt.Fprintf(w, "  - %s\n", v)
+		}
+	}
+}
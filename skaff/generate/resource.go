@@ -0,0 +1,650 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+// operations are the SDK operations a resource is generated from.
+type operations struct {
+	create, read, update, delete, list *sdk.Operation
+}
+
+// rootField is a top-level model field and the SDK fields it maps to.
+type rootField struct {
+	goName, tfName       string
+	create, update, read *sdk.Field
+}
+
+// identifier is the attribute used to read the resource.
+type identifier struct {
+	Attribute  string // Schema attribute key, e.g. names.AttrName.
+	FieldName  string // Model field name, e.g. Name.
+	Var        string // Variable name, e.g. name.
+	InputField string // Read operation input field, e.g. TableName.
+	InputList  bool   // Whether the read operation takes a list of identifiers.
+}
+
+// status is the read shape's status field and its classified enum values.
+type status struct {
+	Field         string // e.g. TableStatus.
+	Type          string // e.g. awstypes.TableStatus.
+	CreatePending []string
+	Ready         []string
+	UpdatePending []string
+	Deleting      []string
+	Deleted       string
+}
+
+// sweeper describes how resources are listed for sweeping.
+type sweeper struct {
+	Function   string // e.g. sweepTables.
+	Operation  string // e.g. ListTables.
+	ItemsField string // e.g. TableNames.
+	IDExpr     string // e.g. v or aws.ToString(v.TableName).
+}
+
+// Status values are classified by their upper-cased value with separators removed.
+var (
+	createPendingStates = []string{"CREATEINPROGRESS", "CREATING", "INITIALIZING", "PENDING", "PENDINGCREATION", "PROVISIONING", "STARTING"}
+	readyStates         = []string{"ACTIVE", "AVAILABLE", "COMPLETED", "CREATECOMPLETE", "CREATED", "DEPLOYED", "ENABLED", "HEALTHY", "INSERVICE", "READY", "RUNNING", "SUCCEEDED"}
+	updatePendingStates = []string{"MODIFYING", "PENDINGUPDATE", "UPDATEINPROGRESS", "UPDATING"}
+	deletingStates      = []string{"DELETEINPROGRESS", "DELETING", "DELETIONINPROGRESS", "PENDINGDELETION"}
+	deletedStates       = []string{"DELETECOMPLETE", "DELETED"}
+)
+
+// genericIdentifierNames are read operation input fields that identify a resource by its ARN or name.
+var genericIdentifierNames = []string{"Identifier", "ResourceIdentifier"}
+
+// prefixedFieldNames are the field names that SDK structures commonly prefix with the resource name (e.g., TableArn).
+var prefixedFieldNames = []string{"Arn", "Id", "Identifier", "Name", "State", "Status"}
+
+// notFoundErrorTypes returns the SDK error types that, in order of preference, indicate that a resource does not exist.
+func notFoundErrorTypes(resourceAWS string) []string {
+	return []string{
+		resourceAWS + "NotFoundException",
+		resourceAWS + "NotFoundFault",
+		"ResourceNotFoundException",
+		"ResourceNotFoundFault",
+		"NotFoundException",
+		"NoSuchEntityException",
+	}
+}
+
+// analyze maps the SDK operations' shapes to a resource schema and fills in the template data.
+func analyze(td *TemplateData, pkg *sdk.Package, ops operations, includeTags bool, r *report) (*fwschema.Schema, error) {
+	prefix := td.ResourceAWS
+	m := &mapper{
+		pkg:      pkg,
+		report:   r,
+		examples: make(map[string]string),
+	}
+
+	shape, err := readShape(td, pkg, ops.read)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := readKey(td, pkg, ops.read, shape, r)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := rootFields(prefix, ops, shape, r)
+	i := slices.IndexFunc(fields, func(f *rootField) bool { return f.goName == rootGoName(ops.read.Input, key, prefix) })
+	if i < 0 && slices.Contains(genericIdentifierNames, key) {
+		// A generic identifier (e.g., Identifier) usually accepts the resource's ARN or name.
+		for _, name := range []string{"ARN", "Name"} {
+			if i = slices.IndexFunc(fields, func(f *rootField) bool { return f.goName == name }); i >= 0 {
+				r.todo("check that %s accepts %s as its %s", ops.read.Name, fields[i].tfName, key)
+				break
+			}
+		}
+	}
+	if i < 0 {
+		return nil, fmt.Errorf("%s: identifier %s not found in %s input or %s output", ops.read.Name, key, ops.create.Name, ops.read.Name)
+	}
+	id := fields[i]
+	td.ID.FieldName = id.goName
+	td.ID.Attribute = namesgen.ConstOrQuote(id.tfName)
+	td.ID.Var = convert.ToLowercasePrefix(id.goName)
+	if token.IsKeyword(td.ID.Var) {
+		td.ID.Var += "Value"
+	}
+
+	td.Status = readStatus(pkg, prefix, shape, ops)
+	td.CreateWaiter = td.Status != nil && len(td.Status.CreatePending) > 0 && len(td.Status.Ready) > 0
+	td.UpdateWaiter = td.Status != nil && ops.update != nil && len(td.Status.UpdatePending) > 0 && len(td.Status.Ready) > 0
+	td.DeleteWaiter = td.Status != nil && len(td.Status.Deleting) > 0
+	td.Timeouts = td.CreateWaiter || td.UpdateWaiter || td.DeleteWaiter
+	if td.Status == nil {
+		r.todo("no status field found in %s output; add waiters if the resource is created or deleted asynchronously", ops.read.Name)
+	}
+
+	s := &fwschema.Schema{}
+	for _, f := range fields {
+		attribute, block, ok := rootAttributeOrBlock(m, f, f == id, ops.update != nil, td.Status, r)
+		if !ok {
+			continue
+		}
+
+		if attribute != nil {
+			s.Attributes = append(s.Attributes, attribute)
+		} else {
+			s.Blocks = append(s.Blocks, block)
+		}
+	}
+
+	if a := s.Attribute(id.tfName); a == nil || a.Type.Kind != fwschema.KindString {
+		return nil, fmt.Errorf("%s: identifier %s is not a string", ops.read.Name, key)
+	}
+
+	if includeTags {
+		td.IncludeTags = true
+		td.TagsInCreate = ops.create.Input.Field("Tags") != nil
+		if !td.TagsInCreate {
+			r.todo("%s does not accept tags; tag the resource after it is created", ops.create.Name)
+		}
+
+		if s.Attribute("arn") != nil {
+			td.TagsIdentifierAttribute = "arn"
+		} else {
+			td.TagsIdentifierAttribute = id.tfName
+			r.todo("check that %q identifies the resource to the tagging API in @Tags", id.tfName)
+		}
+
+		s.Attributes = append(s.Attributes,
+			&fwschema.Attribute{
+				Name:      "tags",
+				FieldName: "Tags",
+				Type:      fwschema.Type{Model: "tftags.Map", Imports: []string{fwschema.ImportTags}},
+				Expr:      "tftags.TagsAttribute()",
+			},
+			&fwschema.Attribute{
+				Name:      "tags_all",
+				FieldName: "TagsAll",
+				Type:      fwschema.Type{Model: "tftags.Map", Imports: []string{fwschema.ImportTags}},
+				Expr:      "tftags.TagsAttributeComputedOnly()",
+			},
+		)
+	}
+
+	if td.Timeouts {
+		var opts []string
+		for _, v := range []struct {
+			name string
+			set  bool
+		}{
+			{"Create", td.CreateWaiter},
+			{"Update", td.UpdateWaiter},
+			{"Delete", td.DeleteWaiter},
+		} {
+			if v.set {
+				opts = append(opts, v.name+": true,")
+			}
+		}
+
+		s.Blocks = append(s.Blocks, &fwschema.Block{
+			Name:      "timeouts",
+			FieldName: "Timeouts",
+			Expr:      fmt.Sprintf("timeouts.Block(ctx, timeouts.Opts{\n%s\n})", strings.Join(opts, "\n")),
+			ModelType: "timeouts.Value",
+		})
+	}
+
+	s.Sort()
+
+	if id.tfName == "arn" {
+		td.Identity = "@ArnIdentity"
+	} else {
+		td.Identity = fmt.Sprintf("@IdentityAttribute(%q)", id.tfName)
+	}
+	if id.tfName != "id" {
+		td.ImportStateIDAttribute = id.tfName
+	}
+
+	if v, ok := pkg.ErrorType(notFoundErrorTypes(td.ResourceAWS)...); ok {
+		td.NotFoundError = "awstypes." + v
+	} else {
+		r.todo("no not found error type found in %s; return a *retry.NotFoundError from find%sBy%s", pkg.Name, td.Resource, td.ID.FieldName)
+	}
+
+	createID(td, pkg, ops.create, id, prefix, r)
+	td.ClientToken = clientTokenField(ops.create.Input)
+	if ops.update != nil {
+		td.UpdateClientToken = clientTokenField(ops.update.Input)
+		td.UpdateIDField = idInputField(ops.update.Input, key, id, prefix)
+	}
+	td.DeleteIDField = idInputField(ops.delete.Input, key, id, prefix)
+
+	td.Sweeper = newSweeper(td, pkg, ops.list, prefix, r)
+
+	td.TestConfig, td.TestConfigUsesName = testConfig(s.Attributes, s.Blocks, "", "  ", m.examples)
+	r.todo("replace placeholder values in testAcc%sConfig_basic", td.Resource)
+
+	return s, nil
+}
+
+// readShape determines the SDK structure returned by the read operation.
+// This is the output's only field if that is an SDK types structure or a list of such structures, or else the output itself.
+func readShape(td *TemplateData, pkg *sdk.Package, read *sdk.Operation) (*sdk.Struct, error) {
+	var ptrs, lists []*sdk.Field
+	var others int
+	for _, f := range read.Output.Fields {
+		if slice, ok := f.Type.(*types.Slice); ok && pkg.IsTypesStruct(slice.Elem()) {
+			lists = append(lists, f)
+		} else if pkg.IsTypesStruct(f.Type) {
+			ptrs = append(ptrs, f)
+		} else if f.Name != "NextToken" {
+			others++
+		}
+	}
+
+	// A structure field only wraps the resource if the output has no other fields of its own.
+	var shape types.Type
+	switch {
+	case others > 0:
+		shape = types.NewPointer(read.Output.Type)
+	case len(ptrs) == 1:
+		shape = ptrs[0].Type
+		td.ReadOutputField = ptrs[0].Name
+	case len(ptrs) == 0 && len(lists) == 1:
+		shape = types.NewPointer(deref(lists[0].Type.(*types.Slice).Elem()))
+		td.ReadOutputField = lists[0].Name
+		td.ReadOutputList = true
+	default:
+		shape = types.NewPointer(read.Output.Type)
+	}
+
+	s, ok := pkg.Struct(shape)
+	if !ok {
+		return nil, errors.New("read output is not a structure")
+	}
+
+	td.ReadShape = pkg.TypeName(shape)
+	td.ReadShapeElem = strings.TrimPrefix(td.ReadShape, "*")
+	if td.ReadOutputField != "" {
+		td.ExistsType = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s/types;awstypes;%s", pkg.Name, td.ReadShapeElem)
+	} else {
+		td.ExistsType = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s;%s", pkg.Name, td.ReadShapeElem)
+	}
+
+	return s, nil
+}
+
+// readKey determines the read operation input field that identifies the resource
+// and returns the corresponding (singular) read shape field name.
+func readKey(td *TemplateData, pkg *sdk.Package, read *sdk.Operation, shape *sdk.Struct, r *report) (string, error) {
+	var key string
+	for _, f := range read.Input.Fields {
+		if f.Required && isStringPtr(f.Type) {
+			if key == "" {
+				key = f.Name
+				td.ID.InputField = f.Name
+			} else {
+				r.todo("find%sBy...: set the required %s input field %s", td.Resource, read.Name, f.Name)
+			}
+		}
+	}
+	if key != "" {
+		return key, nil
+	}
+
+	for _, f := range read.Input.Fields {
+		slice, ok := f.Type.(*types.Slice)
+		if !ok || !isString(slice.Elem()) {
+			continue
+		}
+		if singular, ok := strings.CutSuffix(f.Name, "s"); ok && shape.Field(singular) != nil {
+			td.ID.InputField = f.Name
+			td.ID.InputList = true
+			return singular, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: no identifier found; the input has no required string field and no list of identifiers", read.Name)
+}
+
+// rootGoName returns the model field name for a top-level SDK field.
+// The resource name prefix is removed from identifying fields (e.g., TableName
+// becomes Name) unless that would collide with another field of the same structure.
+func rootGoName(s *sdk.Struct, name, prefix string) string {
+	return convert.ToProviderCapitalization(rootName(s, name, prefix))
+}
+
+func rootName(s *sdk.Struct, name, prefix string) string {
+	if rest, ok := strings.CutPrefix(name, prefix); ok && slices.Contains(prefixedFieldNames, rest) && s.Field(rest) == nil {
+		return rest
+	}
+
+	return name
+}
+
+// rootFields merges the create input, update input and read shape fields into top-level model fields.
+func rootFields(prefix string, ops operations, shape *sdk.Struct, r *report) []*rootField {
+	var fields []*rootField
+
+	add := func(s *sdk.Struct, set func(*rootField, *sdk.Field), create bool) {
+		for _, f := range s.Fields {
+			if skippedFieldNames[f.Name] {
+				continue
+			}
+
+			goName := rootGoName(s, f.Name, prefix)
+			i := slices.IndexFunc(fields, func(v *rootField) bool { return v.goName == goName })
+			if i < 0 {
+				if !create {
+					r.skipped(snakeCase(rootName(s, f.Name, prefix)), fmt.Sprintf("only in %s", s.Name))
+					continue
+				}
+
+				fields = append(fields, &rootField{
+					goName: goName,
+					tfName: snakeCase(rootName(s, f.Name, prefix)),
+				})
+				i = len(fields) - 1
+			}
+			set(fields[i], f)
+		}
+	}
+
+	add(ops.create.Input, func(v *rootField, f *sdk.Field) { v.create = f }, true)
+	add(shape, func(v *rootField, f *sdk.Field) { v.read = f }, true)
+	if ops.update != nil {
+		add(ops.update.Input, func(v *rootField, f *sdk.Field) { v.update = f }, false)
+	}
+
+	return fields
+}
+
+// rootAttributeOrBlock maps a top-level model field to a schema attribute or block.
+func rootAttributeOrBlock(m *mapper, f *rootField, isID, updatable bool, status *status, r *report) (*fwschema.Attribute, *fwschema.Block, bool) {
+	input := f.create
+	if input == nil {
+		input = f.update
+	}
+
+	settable := input != nil
+	var t types.Type
+	if settable {
+		t = input.Type
+	} else {
+		t = f.read.Type
+	}
+
+	attribute, block, ok := m.field(f.tfName, f.tfName, f.goName, t, !settable)
+	if !ok {
+		return nil, nil, false
+	}
+
+	var autoflex string
+	if settable && f.read == nil {
+		autoflex = ",noflatten"
+	} else if settable && !types.Identical(deref(input.Type), deref(f.read.Type)) {
+		autoflex = ",noflatten"
+		r.todo("%s: flatten %s from the read output by hand; its type differs from the input's", f.tfName, f.read.Name)
+	}
+
+	requiresReplace := isID || (f.create != nil && (!updatable || f.update == nil))
+
+	if block != nil {
+		if f.create != nil && f.create.Required {
+			block.MinItems = 1
+		}
+		block.RequiresReplace = settable && requiresReplace
+		block.AutoFlex = autoflex
+
+		return nil, block, true
+	}
+
+	switch {
+	case !settable && f.goName == "ARN" && attribute.Type.Kind == fwschema.KindString:
+		attribute.Expr = "framework.ARNAttributeComputedOnly()"
+	case !settable && f.goName == "ID" && attribute.Type.Kind == fwschema.KindString:
+		attribute.Expr = "framework.IDAttribute()"
+	case !settable:
+		attribute.Computed = true
+		// A status changes during the resource's lifetime, except when it cannot be updated.
+		attribute.UseStateForUnknown = status == nil || f.read.Name != status.Field || !updatable
+	case f.create != nil && f.create.Required:
+		attribute.Required = true
+	default:
+		attribute.Optional = true
+		attribute.Computed = f.read != nil
+	}
+
+	attribute.RequiresReplace = settable && requiresReplace
+	attribute.AutoFlex = autoflex
+	attribute.Sensitive = strings.Contains(f.goName, "Password") || strings.Contains(f.goName, "Secret")
+
+	return attribute, nil, true
+}
+
+// readStatus finds the read shape's status field and classifies its values.
+func readStatus(pkg *sdk.Package, prefix string, shape *sdk.Struct, ops operations) *status {
+	var field *sdk.Field
+	for _, name := range []string{prefix + "Status", "Status", prefix + "State", "State"} {
+		if f := shape.Field(name); f != nil {
+			if _, ok := pkg.Enum(f.Type); ok {
+				field = f
+				break
+			}
+		}
+	}
+	if field == nil {
+		for _, f := range shape.Fields {
+			if _, ok := pkg.Enum(f.Type); ok && (strings.HasSuffix(f.Name, "Status") || strings.HasSuffix(f.Name, "State")) {
+				field = f
+				break
+			}
+		}
+	}
+	if field == nil {
+		return nil
+	}
+
+	values, _ := pkg.Enum(field.Type)
+	s := &status{
+		Field: field.Name,
+		Type:  pkg.TypeName(field.Type),
+	}
+	for _, v := range values {
+		constant := "awstypes." + v.Constant
+		switch normalized := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToUpper(v.Value)); {
+		case slices.Contains(createPendingStates, normalized):
+			s.CreatePending = append(s.CreatePending, constant)
+		case slices.Contains(readyStates, normalized):
+			s.Ready = append(s.Ready, constant)
+		case slices.Contains(updatePendingStates, normalized):
+			s.UpdatePending = append(s.UpdatePending, constant)
+		case slices.Contains(deletingStates, normalized):
+			s.Deleting = append(s.Deleting, constant)
+		case slices.Contains(deletedStates, normalized) && s.Deleted == "":
+			s.Deleted = constant
+		}
+	}
+
+	return s
+}
+
+// createID determines how the identifier is obtained when the resource is created.
+// If it is not configured, it is taken from the create operation's output.
+func createID(td *TemplateData, pkg *sdk.Package, create *sdk.Operation, id *rootField, prefix string, r *report) {
+	if id.create != nil {
+		return
+	}
+
+	for _, f := range create.Output.Fields {
+		if rootGoName(create.Output, f.Name, prefix) == id.goName && isStringPtr(f.Type) {
+			td.CreateIDExpr = fmt.Sprintf("aws.ToString(output.%s)", f.Name)
+			return
+		}
+	}
+
+	for _, f := range create.Output.Fields {
+		if _, ok := f.Type.(*types.Pointer); !ok || !pkg.IsTypesStruct(f.Type) {
+			continue
+		}
+
+		s, _ := pkg.Struct(f.Type)
+		for _, g := range s.Fields {
+			if rootGoName(s, g.Name, prefix) == id.goName && isStringPtr(g.Type) {
+				td.CreateOutputField = f.Name
+				td.CreateIDExpr = fmt.Sprintf("aws.ToString(output.%s.%s)", f.Name, g.Name)
+				return
+			}
+		}
+	}
+
+	r.todo("%s: set %s from the %s output", td.ID.Var, td.ID.FieldName, create.Name)
+}
+
+// idInputField returns the name of the input field that takes the identifier
+// when AutoFlex cannot populate it from the model, or "".
+func idInputField(s *sdk.Struct, key string, id *rootField, prefix string) string {
+	if f := s.Field(key); f != nil && isStringPtr(f.Type) && rootGoName(s, key, prefix) != id.goName {
+		return key
+	}
+
+	return ""
+}
+
+func clientTokenField(s *sdk.Struct) string {
+	for _, name := range []string{"ClientToken", "ClientRequestToken"} {
+		if f := s.Field(name); f != nil && isStringPtr(f.Type) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// newSweeper determines how resources are listed for sweeping, or returns nil.
+func newSweeper(td *TemplateData, pkg *sdk.Package, list *sdk.Operation, prefix string, r *report) *sweeper {
+	if list == nil {
+		r.skipped("sweeper", "no list operation")
+		return nil
+	}
+	if !pkg.HasPaginator(list.Name) {
+		r.skipped("sweeper", fmt.Sprintf("%s has no paginator", list.Name))
+		return nil
+	}
+
+	for _, f := range list.Output.Fields {
+		slice, ok := f.Type.(*types.Slice)
+		if !ok {
+			continue
+		}
+
+		sw := &sweeper{
+			Function:   "sweep" + plural(td.Resource),
+			Operation:  list.Name,
+			ItemsField: f.Name,
+		}
+
+		if isString(slice.Elem()) {
+			sw.IDExpr = "v"
+			return sw
+		}
+
+		s, ok := pkg.Struct(slice.Elem())
+		if !ok {
+			continue
+		}
+		for _, g := range s.Fields {
+			if rootGoName(s, g.Name, prefix) != td.ID.FieldName {
+				continue
+			}
+			switch {
+			case isStringPtr(g.Type):
+				sw.IDExpr = fmt.Sprintf("aws.ToString(v.%s)", g.Name)
+				return sw
+			case isString(g.Type):
+				sw.IDExpr = "v." + g.Name
+				return sw
+			}
+		}
+	}
+
+	r.skipped("sweeper", fmt.Sprintf("%s output has no %s", list.Name, td.ID.FieldName))
+
+	return nil
+}
+
+// testConfig returns the HCL arguments for the required attributes and blocks, and whether the test's name variable is used.
+func testConfig(attributes []*fwschema.Attribute, blocks []*fwschema.Block, path, indent string, examples map[string]string) (string, bool) {
+	var sb strings.Builder
+	var usesName bool
+
+	var required []*fwschema.Attribute
+	width := 0
+	for _, a := range attributes {
+		if a.Required {
+			required = append(required, a)
+			width = max(width, len(a.Name))
+		}
+	}
+
+	for _, a := range required {
+		attrPath := a.Name
+		if path != "" {
+			attrPath = path + "." + a.Name
+		}
+
+		var value string
+		switch {
+		case examples[attrPath] != "":
+			value = examples[attrPath]
+		case a.Type.Kind == fwschema.KindString && (a.Name == "name" || strings.HasSuffix(a.Name, "_name")):
+			value = "%[1]q"
+			usesName = true
+		case a.Type.Kind == fwschema.KindString:
+			value = `"TODO"`
+		case a.Type.Kind == fwschema.KindBool:
+			value = "false"
+		case a.Type.Kind == fwschema.KindList || a.Type.Kind == fwschema.KindSet:
+			value = "[]"
+		case a.Type.Kind == fwschema.KindMap:
+			value = "{}"
+		default:
+			value = "1"
+		}
+
+		fmt.Fprintf(&sb, "%s%-*s = %s\n", indent, width, a.Name, value)
+	}
+
+	for _, b := range blocks {
+		if b.MinItems == 0 || b.Expr != "" {
+			continue
+		}
+
+		blockPath := b.Name
+		if path != "" {
+			blockPath = path + "." + b.Name
+		}
+
+		body, ok := testConfig(b.Attributes, b.Blocks, blockPath, indent+"  ", examples)
+		usesName = usesName || ok
+		fmt.Fprintf(&sb, "\n%s%s {\n%s%s}\n", indent, b.Name, body, indent)
+	}
+
+	return sb.String(), usesName
+}
+
+func isStringPtr(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+
+	return ok && isString(ptr.Elem())
+}
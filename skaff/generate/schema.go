@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+var digitsRegexp = regexache.MustCompile(`_([0-9]+)`)
+
+// maxNestingDepth limits how deeply nested SDK structures are mapped to nested blocks and attributes.
+const maxNestingDepth = 5
+
+// skippedFieldNames are SDK fields that are never mapped to schema attributes.
+var skippedFieldNames = map[string]bool{
+	"ClientRequestToken": true,
+	"ClientToken":        true,
+	"DryRun":             true,
+	"MaxResults":         true,
+	"NextToken":          true,
+	"Tags":               true,
+}
+
+// mapper maps SDK types to schema attributes and nested blocks.
+type mapper struct {
+	pkg    *sdk.Package
+	report *report
+	// stack holds the names of the SDK structures being mapped, to detect recursive types.
+	stack []string
+	// examples holds, by attribute path, example values used in test configurations.
+	examples map[string]string
+}
+
+// field maps an SDK field to either a schema attribute or, for settable
+// structures, a nested block. Computed structures are mapped to nested object
+// attributes, as blocks cannot be computed.
+// It returns false, and records why, if the field cannot be mapped.
+func (m *mapper) field(path, tfName, goName string, t types.Type, computed bool) (*fwschema.Attribute, *fwschema.Block, bool) {
+	if typ, ok := m.attributeType(t, goName, computed); ok {
+		if values, ok := m.pkg.Enum(deref(t)); ok && len(values) > 0 {
+			m.examples[path] = strconv.Quote(values[0].Value)
+		}
+
+		return &fwschema.Attribute{
+			Name:      tfName,
+			FieldName: goName,
+			Type:      typ,
+		}, nil, true
+	}
+
+	elem, isList := t, false
+	if slice, ok := deref(t).(*types.Slice); ok {
+		elem, isList = slice.Elem(), true
+	}
+	if !m.pkg.IsTypesStruct(elem) {
+		m.report.skipped(path, fmt.Sprintf("unsupported type %s", m.pkg.TypeName(t)))
+		return nil, nil, false
+	}
+
+	block, ok := m.nested(path, tfName, goName, elem, computed)
+	if !ok {
+		return nil, nil, false
+	}
+	if !isList {
+		block.MaxItems = 1
+	}
+
+	if !computed {
+		return nil, block, true
+	}
+
+	return &fwschema.Attribute{
+		Name:      tfName,
+		FieldName: goName,
+		Type: fwschema.Type{
+			Kind:        fwschema.KindList,
+			Model:       block.ModelFieldType(),
+			CustomType:  fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", block.Model),
+			ElementType: fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", block.Model),
+			Imports:     []string{fwschema.ImportFWTypes},
+		},
+		Nested: block,
+	}, nil, true
+}
+
+// nested maps an SDK structure to a nested block.
+func (m *mapper) nested(path, tfName, goName string, t types.Type, computed bool) (*fwschema.Block, bool) {
+	s, _ := m.pkg.Struct(t)
+
+	if len(m.stack) >= maxNestingDepth {
+		m.report.skipped(path, fmt.Sprintf("%s is nested more than %d levels deep", s.Name, maxNestingDepth))
+		return nil, false
+	}
+	for _, v := range m.stack {
+		if v == s.Name {
+			m.report.skipped(path, fmt.Sprintf("%s is recursive", s.Name))
+			return nil, false
+		}
+	}
+
+	m.stack = append(m.stack, s.Name)
+	defer func() { m.stack = m.stack[:len(m.stack)-1] }()
+
+	block := &fwschema.Block{
+		Name:      tfName,
+		FieldName: goName,
+		Model:     convert.ToLowercasePrefix(s.Name) + "Model",
+	}
+
+	for _, f := range s.Fields {
+		if skippedFieldNames[f.Name] {
+			continue
+		}
+
+		goName, tfName := convert.ToProviderCapitalization(f.Name), snakeCase(f.Name)
+		attribute, nestedBlock, ok := m.field(path+"."+tfName, tfName, goName, f.Type, computed)
+		if !ok {
+			continue
+		}
+
+		if attribute != nil {
+			switch {
+			case computed:
+				attribute.Computed = true
+			case f.Required:
+				attribute.Required = true
+			default:
+				attribute.Optional = true
+			}
+			block.Attributes = append(block.Attributes, attribute)
+		} else {
+			if f.Required {
+				nestedBlock.MinItems = 1
+			}
+			block.Blocks = append(block.Blocks, nestedBlock)
+		}
+	}
+
+	return block, true
+}
+
+// attributeType returns the schema type for a scalar, collection or map SDK type,
+// or false if t is a structure or is not supported.
+func (m *mapper) attributeType(t types.Type, goName string, computed bool) (fwschema.Type, bool) {
+	if typ, ok := m.scalarType(t); ok {
+		if typ.Kind == fwschema.KindString && typ.Model == "types.String" && !computed && strings.HasSuffix(goName, "ARN") {
+			return fwschema.Type{
+				Kind:       fwschema.KindString,
+				Model:      "fwtypes.ARN",
+				CustomType: "fwtypes.ARNType",
+				Imports:    []string{fwschema.ImportFWTypes},
+			}, true
+		}
+
+		return typ, true
+	}
+
+	switch t := deref(t).(type) {
+	case *types.Slice:
+		elem := t.Elem()
+		if _, ok := m.pkg.Enum(elem); ok {
+			name := m.pkg.TypeName(elem)
+			return fwschema.Type{
+				Kind:        fwschema.KindList,
+				Model:       fmt.Sprintf("fwtypes.ListOfStringEnum[%s]", name),
+				CustomType:  fmt.Sprintf("fwtypes.ListOfStringEnumType[%s]()", name),
+				ElementType: fmt.Sprintf("fwtypes.StringEnumType[%s]()", name),
+				Imports:     []string{fwschema.ImportFWTypes},
+			}, true
+		}
+
+		if typ, ok := m.scalarType(elem); ok && typ.Model == "types."+string(typ.Kind) {
+			if typ.Kind == fwschema.KindString {
+				return fwschema.Type{
+					Kind:        fwschema.KindList,
+					Model:       "fwtypes.ListOfString",
+					CustomType:  "fwtypes.ListOfStringType",
+					ElementType: "types.StringType",
+					Imports:     []string{fwschema.ImportFWTypes},
+				}, true
+			}
+
+			return fwschema.Type{
+				Kind:        fwschema.KindList,
+				Model:       "types.List",
+				ElementType: fmt.Sprintf("types.%sType", typ.Kind),
+				Imports:     []string{fwschema.ImportTypes},
+			}, true
+		}
+
+	case *types.Map:
+		if isString(t.Key()) && isString(t.Elem()) {
+			return fwschema.Type{
+				Kind:        fwschema.KindMap,
+				Model:       "fwtypes.MapOfString",
+				CustomType:  "fwtypes.MapOfStringType",
+				ElementType: "types.StringType",
+				Imports:     []string{fwschema.ImportFWTypes},
+			}, true
+		}
+	}
+
+	return fwschema.Type{}, false
+}
+
+// scalarType returns the schema type for a scalar SDK type, or false if t is not a scalar.
+func (m *mapper) scalarType(t types.Type) (fwschema.Type, bool) {
+	t = deref(t)
+
+	if named, ok := t.(*types.Named); ok {
+		if obj := named.Obj(); obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return fwschema.Type{
+				Kind:       fwschema.KindString,
+				Model:      "timetypes.RFC3339",
+				CustomType: "timetypes.RFC3339Type{}",
+				Imports:    []string{fwschema.ImportTimeTypes},
+			}, true
+		}
+
+		if _, ok := m.pkg.Enum(t); ok {
+			name := m.pkg.TypeName(t)
+			return fwschema.Type{
+				Kind:       fwschema.KindString,
+				Model:      fmt.Sprintf("fwtypes.StringEnum[%s]", name),
+				CustomType: fmt.Sprintf("fwtypes.StringEnumType[%s]()", name),
+				Imports:    []string{fwschema.ImportFWTypes},
+			}, true
+		}
+
+		return fwschema.Type{}, false
+	}
+
+	basic, ok := t.(*types.Basic)
+	if !ok {
+		return fwschema.Type{}, false
+	}
+
+	var kind fwschema.Kind
+	switch basic.Kind() {
+	case types.Bool:
+		kind = fwschema.KindBool
+	case types.Float32:
+		kind = fwschema.KindFloat32
+	case types.Float64:
+		kind = fwschema.KindFloat64
+	case types.Int32:
+		kind = fwschema.KindInt32
+	case types.Int, types.Int64:
+		kind = fwschema.KindInt64
+	case types.String:
+		kind = fwschema.KindString
+	default:
+		return fwschema.Type{}, false
+	}
+
+	return fwschema.Type{
+		Kind:    kind,
+		Model:   "types." + string(kind),
+		Imports: []string{fwschema.ImportTypes},
+	}, true
+}
+
+// snakeCase converts an SDK field name to a Terraform attribute name, keeping
+// digits with the preceding word (e.g., Ipv6CidrBlock becomes ipv6_cidr_block).
+func snakeCase(s string) string {
+	return digitsRegexp.ReplaceAllString(names.ToSnakeCase(s), "$1")
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+
+	return ok && basic.Kind() == types.String
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,600 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package migrate translates a Terraform Plugin SDKv2 resource to a
+// Plugin Framework resource.
+package migrate
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+)
+
+//go:embed resource.gtpl
+var resourceTmpl string
+
+//go:embed migrate.gtpl
+var migrateTmpl string
+
+// Options are the options for migrating a resource.
+type Options struct {
+	Name  string // e.g. FlowLog or aws_flow_log.
+	Force bool
+}
+
+type TemplateData struct {
+	Resource             string
+	ResourceLowerCamel   string
+	HumanResourceName    string
+	ProviderResourceName string
+	ServicePackage       string
+	Service              string
+	SourceFile           string
+
+	Annotations []string
+	Imports     []string
+	Schema      string
+	Models      string
+
+	Timeouts         []timeout
+	ConfigValidators []string
+	Identity         bool
+	ImportState      string // passthrough or custom, if the resource is importable.
+	ImporterFunc     string
+
+	CreateFunc string
+	ReadFunc   string
+	UpdateFunc string
+	DeleteFunc string
+	Update     bool
+
+	Upgraders      []upgrader
+	MigrateImports []string
+}
+
+// upgrader is a state upgrader from a prior schema version.
+type upgrader struct {
+	Version    int
+	SchemaFunc string // e.g. flowLogSchemaV0.
+	Model      string // e.g. flowLogResourceModelV0.
+	Function   string // e.g. upgradeFlowLogResourceStateFromV0.
+	SDKFunc    string // e.g. flowLogStateUpgradeV0 (vpc_flow_log_migrate.go).
+	Schema     string
+	Models     string
+	Copies     []string // Fields copied unchanged to the current model.
+	Todos      []string // Fields of the current model that need values.
+}
+
+// resourceFields are the schema.Resource fields that are translated.
+var resourceFields = []string{
+	"Create", "CreateContext", "CreateWithoutTimeout",
+	"Read", "ReadContext", "ReadWithoutTimeout",
+	"Update", "UpdateContext", "UpdateWithoutTimeout",
+	"Delete", "DeleteContext", "DeleteWithoutTimeout",
+	"CustomizeDiff",
+	"Importer",
+	"Schema",
+	"SchemaFunc",
+	"SchemaVersion",
+	"StateUpgraders",
+	"Timeouts",
+}
+
+// sdkAnnotations are annotations that only apply to SDKv2 resources.
+var sdkAnnotations = []string{
+	"@IdentityFix",
+	"@V60SDKv2Fix",
+	"@WrappedImport",
+}
+
+// identityAnnotations are annotations that give a resource an identity.
+var identityAnnotations = []string{
+	"@ArnIdentity",
+	"@CustomInherentRegionIdentity",
+	"@IdentityAttribute",
+	"@SingletonIdentity",
+}
+
+var sdkResourceAnnotation = regexp.MustCompile(`^@SDKResource\("([^"]+)"(?:,\s*name="([^"]*)")?`)
+
+func Create(opts Options) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if opts.Name == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	src, err := parseDir(wd)
+	if err != nil {
+		return err
+	}
+	if err := src.loadNames(); err != nil {
+		return err
+	}
+
+	decl, err := src.resourceFunc(opts.Name)
+	if err != nil {
+		return err
+	}
+
+	base := strings.TrimSuffix(src.filename(decl), ".go")
+	f, mf := base+"_fw.go", base+"_fw_migrate.go"
+	src = src.without(f, mf)
+
+	templateData := TemplateData{
+		ServicePackage: servicePackage,
+		Service:        service.ProviderNameUpper(),
+	}
+
+	var r report
+	td := &templateData
+	if err := migrate(td, src, decl, &r); err != nil {
+		return err
+	}
+
+	if err := writeTemplate("migrateres", f, resourceTmpl, opts.Force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+	files := []string{f}
+
+	if len(td.Upgraders) > 0 {
+		if err := writeTemplate("migratestate", mf, migrateTmpl, opts.Force, templateData); err != nil {
+			return fmt.Errorf("writing state upgrade template: %w", err)
+		}
+		files = append(files, mf)
+	}
+
+	r.todo("port the CRUD handlers marked TODO, using AutoFlex (flex.Expand and flex.Flatten) where the model allows")
+	r.todo("remove %s and its annotations, and move any helpers still in use", decl.Name.Name)
+	r.todo("run go generate to register the framework resource")
+	r.todo("replace Resource%s = %s with Resource%[1]s = new%[1]sResource in exports_test.go", td.Resource, decl.Name.Name)
+	r.todo("use acctest.CheckFrameworkResourceDisappears in the resource's disappears test")
+	r.todo("add a test that upgrades state from the last SDKv2 provider version (see acctest.ExternalProviders)")
+
+	fmt.Printf("Migrated %s (%s) to %s\n", decl.Name.Name, td.SourceFile, strings.Join(files, " and "))
+	r.write(os.Stdout)
+
+	return nil
+}
+
+// migrate translates the SDKv2 resource returned by decl.
+func migrate(td *TemplateData, src *source, decl *ast.FuncDecl, r *report) error {
+	res, err := resourceLit(src, decl)
+	if err != nil {
+		return err
+	}
+
+	td.SourceFile = src.filename(decl)
+	td.Resource = strings.TrimPrefix(strings.TrimPrefix(decl.Name.Name, "resource"), "Resource")
+	td.ResourceLowerCamel = convert.ToLowercasePrefix(td.Resource)
+	td.HumanResourceName = convert.ToHumanResName(td.Resource)
+
+	global := false
+	for _, v := range annotations(decl.Doc) {
+		if m := sdkResourceAnnotation.FindStringSubmatch(v); m != nil {
+			td.ProviderResourceName = m[1]
+			if m[2] != "" {
+				td.HumanResourceName = m[2]
+			}
+			continue
+		}
+
+		if slices.ContainsFunc(sdkAnnotations, func(s string) bool { return strings.HasPrefix(v, s) }) {
+			r.skipped(decl.Name.Name, fmt.Sprintf("annotation %s applies only to SDKv2 resources", v))
+			continue
+		}
+		if slices.ContainsFunc(identityAnnotations, func(s string) bool { return strings.HasPrefix(v, s) }) {
+			td.Identity = true
+		}
+		if v == "@Region(global=true)" {
+			global = true
+		}
+		td.Annotations = append(td.Annotations, v)
+	}
+	if td.ProviderResourceName == "" {
+		return fmt.Errorf("%s has no @SDKResource annotation", decl.Name.Name)
+	}
+
+	t := &translator{
+		src:                src,
+		report:             r,
+		resourceLowerCamel: td.ResourceLowerCamel,
+		models:             make(map[string]bool),
+	}
+
+	s, timeouts, err := t.resourceSchema(res)
+	if err != nil {
+		return fmt.Errorf("%s: %w", decl.Name.Name, err)
+	}
+	td.Timeouts = timeouts
+	td.ConfigValidators = t.configValidators
+
+	f := fields(res)
+	for _, key := range slices.Sorted(maps.Keys(f)) {
+		switch {
+		case slices.Contains(resourceFields, key):
+		case key == "MigrateState":
+			r.skipped(decl.Name.Name, fmt.Sprintf("MigrateState %s; the framework only upgrades state from schema version 0 or later", t.describe(f[key])))
+		case key == "ValidateRawResourceConfigFuncs":
+			r.skipped(decl.Name.Name, fmt.Sprintf("ValidateRawResourceConfigFuncs %s; implement resource.ResourceWithConfigValidators", t.describe(f[key])))
+		default:
+			r.skipped(decl.Name.Name, fmt.Sprintf("%s %s", key, t.describe(f[key])))
+		}
+	}
+
+	td.CreateFunc = crudFunc(src, f, "Create")
+	td.ReadFunc = crudFunc(src, f, "Read")
+	td.UpdateFunc = crudFunc(src, f, "Update")
+	td.DeleteFunc = crudFunc(src, f, "Delete")
+
+	tags := s.Attribute("tags") != nil
+	td.Update = td.UpdateFunc != "" || tags
+
+	if v, ok := f["CustomizeDiff"]; ok {
+		customizeDiff(t, v)
+	}
+
+	if v, ok := f["Importer"]; ok {
+		importer(t, td, v)
+	}
+
+	if v, ok := f["StateUpgraders"]; ok {
+		upgraders(t, td, s, v)
+	}
+
+	imports := make(fwschema.Imports)
+	td.Schema = s.RenderSchema(imports)
+	var embeds []string
+	if !global {
+		embeds = append(embeds, "framework.WithRegionModel")
+	}
+	td.Models = s.RenderModels(imports, td.ResourceLowerCamel+"ResourceModel", embeds...)
+	addResourceImports(imports)
+	src.addImports(td.SourceFile, imports)
+	td.Imports = imports.GroupedSpecs()
+
+	return nil
+}
+
+// resourceLit returns the schema.Resource literal returned by a resource function.
+func resourceLit(src *source, decl *ast.FuncDecl) (*ast.CompositeLit, error) {
+	if decl.Body != nil {
+		for _, stmt := range slices.Backward(decl.Body.List) {
+			ret, ok := stmt.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				continue
+			}
+			if lit, ok := src.compositeLit(ret.Results[0]); ok && selectorName(lit.Type) == "schema.Resource" {
+				return lit, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s does not return a schema.Resource literal", decl.Name.Name)
+}
+
+// crudFunc describes the SDKv2 handler for an operation, e.g. resourceFlowLogCreate (vpc_flow_log.go).
+func crudFunc(src *source, f map[string]ast.Expr, operation string) string {
+	for _, key := range []string{operation + "WithoutTimeout", operation + "Context", operation} {
+		v, ok := f[key]
+		if !ok {
+			continue
+		}
+
+		name := selectorName(v)
+		if decl, ok := src.funcs[name]; ok {
+			return fmt.Sprintf("%s (%s)", name, src.filename(decl))
+		}
+		if name != "" {
+			return name
+		}
+		return "the " + key + " function literal"
+	}
+
+	return ""
+}
+
+// customizeDiff reports the plan customizations that need porting.
+func customizeDiff(t *translator, e ast.Expr) {
+	var funcs []ast.Expr
+	if call, ok := e.(*ast.CallExpr); ok && strings.HasPrefix(selectorName(call.Fun), "customdiff.") {
+		funcs = call.Args
+	} else {
+		funcs = []ast.Expr{e}
+	}
+
+	for _, v := range funcs {
+		if selectorName(v) == "verify.SetTagsDiff" {
+			// The framework's transparent tagging computes tags_all.
+			continue
+		}
+		t.report.skipped("CustomizeDiff", fmt.Sprintf("%s; implement resource.ResourceWithModifyPlan", t.describe(v)))
+	}
+}
+
+// importer translates a schema.ResourceImporter.
+func importer(t *translator, td *TemplateData, e ast.Expr) {
+	lit, ok := t.src.compositeLit(e)
+	if !ok {
+		t.report.skipped("Importer", fmt.Sprintf("%s is not a literal", t.describe(e)))
+		td.ImportState = "custom"
+		return
+	}
+
+	f := fields(lit)
+	v, ok := f["StateContext"]
+	if !ok {
+		v, ok = f["State"]
+	}
+	if !ok {
+		return
+	}
+
+	switch name := selectorName(v); name {
+	case "schema.ImportStatePassthroughContext", "schema.ImportStatePassthrough":
+		td.ImportState = "passthrough"
+	default:
+		td.ImportState = "custom"
+		td.ImporterFunc = t.describe(v)
+		if decl, ok := t.src.funcs[name]; ok {
+			td.ImporterFunc = fmt.Sprintf("%s (%s)", name, t.src.filename(decl))
+		}
+		t.report.skipped("Importer", fmt.Sprintf("custom importer %s; port it to ImportState", td.ImporterFunc))
+	}
+}
+
+// upgraders translates a resource's schema.StateUpgrader literals.
+// Each framework upgrader upgrades to the current schema version directly.
+func upgraders(t *translator, td *TemplateData, current *fwschema.Schema, e ast.Expr) {
+	lit, ok := t.src.compositeLit(e)
+	if !ok {
+		t.report.skipped("StateUpgraders", fmt.Sprintf("%s is not a literal", t.describe(e)))
+		return
+	}
+
+	imports := make(fwschema.Imports)
+	var files []string
+	for _, elt := range lit.Elts {
+		u, ok := t.src.compositeLit(elt)
+		if !ok {
+			t.report.skipped("StateUpgraders", fmt.Sprintf("%s is not a literal", t.describe(elt)))
+			continue
+		}
+		f := fields(u)
+
+		version, ok := t.src.intValue(f["Version"])
+		if !ok {
+			t.report.skipped("StateUpgraders", fmt.Sprintf("Version %s is not a constant", t.describe(f["Version"])))
+			continue
+		}
+		path := fmt.Sprintf("StateUpgraders[%d]", version)
+
+		fn, ok := priorSchemaFunc(f["Type"])
+		if !ok {
+			t.report.skipped(path, fmt.Sprintf("Type %s is not the implied type of a schema.Resource function", t.describe(f["Type"])))
+			continue
+		}
+		res, ok := t.src.funcs[fn]
+		if !ok {
+			t.report.skipped(path, fmt.Sprintf("function %s not found", fn))
+			continue
+		}
+		resLit, err := resourceLit(t.src, res)
+		if err != nil {
+			t.report.skipped(path, err.Error())
+			continue
+		}
+
+		suffix := fmt.Sprintf("V%d", version)
+		pt := &translator{
+			src:                t.src,
+			report:             t.report,
+			resourceLowerCamel: t.resourceLowerCamel,
+			modelSuffix:        suffix,
+			models:             t.models,
+		}
+		prior, timeouts, err := pt.resourceSchema(resLit)
+		if err != nil {
+			t.report.skipped(path, err.Error())
+			continue
+		}
+		if len(timeouts) > 0 {
+			t.report.todo("%s: remove the timeouts block from the prior schema unless the prior state has one", path)
+		}
+		prior.Version = int64(version)
+
+		up := upgrader{
+			Version:    version,
+			SchemaFunc: t.unusedName(fmt.Sprintf("%sSchema%s", td.ResourceLowerCamel, suffix), fmt.Sprintf("%sResourceSchema%s", td.ResourceLowerCamel, suffix)),
+			Model:      t.unusedName(fmt.Sprintf("%sResourceModel%s", td.ResourceLowerCamel, suffix)),
+			Function:   t.unusedName(fmt.Sprintf("upgrade%sResourceStateFrom%s", td.Resource, suffix)),
+			SDKFunc:    t.describe(f["Upgrade"]),
+		}
+		if decl, ok := t.src.funcs[selectorName(f["Upgrade"])]; ok {
+			up.SDKFunc = fmt.Sprintf("%s (%s)", decl.Name.Name, t.src.filename(decl))
+		}
+
+		up.Schema = prior.RenderSchema(imports)
+		up.Models = prior.RenderModels(imports, up.Model)
+		files = append(files, t.src.filename(res))
+		up.Copies, up.Todos = copyFields(current, prior)
+
+		td.Upgraders = append(td.Upgraders, up)
+	}
+
+	addMigrateImports(imports)
+	for _, v := range files {
+		t.src.addImports(v, imports)
+	}
+	td.MigrateImports = imports.GroupedSpecs()
+
+	if len(td.Upgraders) > 1 {
+		t.report.todo("the SDKv2 state upgraders ran in sequence; each framework upgrader must upgrade to the current version")
+	}
+}
+
+// priorSchemaFunc returns the function in a StateUpgrader Type expression,
+// e.g. flowLogSchemaV0 in flowLogSchemaV0().CoreConfigSchema().ImpliedType().
+func priorSchemaFunc(e ast.Expr) (string, bool) {
+	for {
+		call, ok := e.(*ast.CallExpr)
+		if !ok {
+			return "", false
+		}
+
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			return fun.Name, true
+		case *ast.SelectorExpr:
+			e = fun.X
+		default:
+			return "", false
+		}
+	}
+}
+
+// copyFields returns the current model fields whose prior value can be copied unchanged
+// and the current model fields that need a value.
+func copyFields(current, prior *fwschema.Schema) ([]string, []string) {
+	type field struct {
+		name, typ string
+	}
+	fields := func(s *fwschema.Schema) []field {
+		var fields []field
+		for _, a := range s.Attributes {
+			fields = append(fields, field{a.FieldName, a.Type.Model})
+		}
+		for _, b := range s.Blocks {
+			fields = append(fields, field{b.FieldName, b.ModelFieldType()})
+		}
+		slices.SortFunc(fields, func(a, b field) int { return strings.Compare(a.name, b.name) })
+		return fields
+	}
+
+	priorFields := fields(prior)
+	var copies, todos []string
+	for _, v := range fields(current) {
+		if slices.Contains(priorFields, v) {
+			copies = append(copies, v.name)
+		} else {
+			todos = append(todos, v.name)
+		}
+	}
+
+	return copies, todos
+}
+
+// unusedName returns the first candidate that is not a package-level identifier.
+func (t *translator) unusedName(candidates ...string) string {
+	for _, v := range candidates {
+		if !t.src.idents[v] {
+			return v
+		}
+	}
+
+	last := candidates[len(candidates)-1]
+	for i := 2; ; i++ {
+		if v := fmt.Sprintf("%s%d", last, i); !t.src.idents[v] {
+			return v
+		}
+	}
+}
+
+// addResourceImports adds every package the resource template may use to the schema's imports.
+// Unused imports are removed when the source is formatted.
+func addResourceImports(imports fwschema.Imports) {
+	for _, v := range []string{
+		"context",
+		"time",
+		"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator",
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-provider-aws/internal/smerr",
+		fwschema.ImportFramework,
+		fwschema.ImportNames,
+		fwschema.ImportPath,
+		fwschema.ImportTimeouts,
+		fwschema.ImportTags,
+		fwschema.ImportFWTypes,
+	} {
+		imports.Add(v)
+	}
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := fwschema.Format(filename, buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return write(filename, force, bytes.NewReader(contents))
+}
+
+// addMigrateImports adds every package the state upgrade template may use to the prior schemas' imports.
+func addMigrateImports(imports fwschema.Imports) {
+	for _, v := range []string{
+		"context",
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-provider-aws/internal/smerr",
+	} {
+		imports.Add(v)
+	}
+}
+
+func write(filename string, force bool, reader io.Reader) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	if _, err := f.ReadFrom(reader); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+)
+
+const testSource = `package example
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Tags(identifierAttribute="arn")
+// @WrappedImport(false)
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWidgetV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: widgetStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"color": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          awstypes.ColorRed,
+					ValidateDiagFunc: enum.Validate[awstypes.Color](),
+				},
+				names.AttrName: {
+					Type:          schema.TypeString,
+					Required:      true,
+					ForceNew:      true,
+					ValidateFunc:  validation.StringLenBetween(1, 64),
+					ConflictsWith: []string{"name_prefix"},
+				},
+				"size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 10),
+				},
+				"settings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Required: true,
+							},
+						},
+					},
+				},
+				"state": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"code": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"protocol": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					StateFunc: protocolStateFunc,
+				},
+				names.AttrTags:    tftags.TagsSchema(),
+				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			}
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceWidgetV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// widgetSchemaV0 is an existing identifier.
+func widgetSchemaV0() {}
+`
+
+func testMigrate(t *testing.T) (TemplateData, report) {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "widget.go", testSource, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("parsing: %s", err)
+	}
+	src := newSource(fset, f)
+	src.names = map[string]string{
+		"AttrARN":     "arn",
+		"AttrName":    "name",
+		"AttrTags":    "tags",
+		"AttrTagsAll": "tags_all",
+	}
+
+	decl, err := src.resourceFunc("aws_example_widget")
+	if err != nil {
+		t.Fatalf("finding resource: %s", err)
+	}
+
+	td := TemplateData{
+		ServicePackage: "example",
+		Service:        "Example",
+	}
+	var r report
+	if err := migrate(&td, src, decl, &r); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return td, r
+}
+
+func TestMigrate(t *testing.T) {
+	td, r := testMigrate(t)
+
+	if got, expected := td.Resource, "Widget"; got != expected {
+		t.Errorf("Resource: got %s, expected %s", got, expected)
+	}
+	if got, expected := td.HumanResourceName, "Widget"; got != expected {
+		t.Errorf("HumanResourceName: got %s, expected %s", got, expected)
+	}
+	if got, expected := td.Annotations, []string{`@Tags(identifierAttribute="arn")`}; !slices.Equal(got, expected) {
+		t.Errorf("Annotations: got %v, expected %v", got, expected)
+	}
+	if got, expected := td.Timeouts, []timeout{{Operation: "Create", Duration: "10 * time.Minute"}}; !slices.Equal(got, expected) {
+		t.Errorf("Timeouts: got %v, expected %v", got, expected)
+	}
+	if got, expected := td.ImportState, "passthrough"; got != expected {
+		t.Errorf("ImportState: got %s, expected %s", got, expected)
+	}
+	if got, expected := td.CreateFunc, "resourceWidgetCreate"; got != expected {
+		t.Errorf("CreateFunc: got %s, expected %s", got, expected)
+	}
+	if !td.Update {
+		t.Error("no Update, expected one for tags")
+	}
+
+	for _, expected := range []string{
+		`"color": schema.StringAttribute{`,
+		`CustomType: fwtypes.StringEnumType[awstypes.Color](),`,
+		`Default: fwtypes.StringEnumType[awstypes.Color]().AttributeDefault(awstypes.ColorRed),`,
+		`stringvalidator.LengthBetween(1, 64),`,
+		`stringvalidator.ConflictsWith(path.MatchRoot(names.AttrNamePrefix)),`,
+		`int64validator.Between(1, 10),`,
+		`"settings": schema.ListNestedBlock{`,
+		`listvalidator.SizeAtMost(1),`,
+		`names.AttrState: schema.ListAttribute{`,
+		`CustomType: fwtypes.NewListNestedObjectTypeOf[stateModel](ctx),`,
+		`ElementType: fwtypes.NewObjectTypeOf[stateModel](ctx),`,
+		`names.AttrID: framework.IDAttribute(),`,
+		`names.AttrTags: tftags.TagsAttribute(),`,
+		`names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{`,
+	} {
+		if !strings.Contains(td.Schema, expected) {
+			t.Errorf("schema does not contain %s:\n%s", expected, td.Schema)
+		}
+	}
+	for _, expected := range []string{
+		"type widgetResourceModel struct {",
+		"framework.WithRegionModel",
+		"Settings fwtypes.ListNestedObjectValueOf[settingsModel]",
+		"type settingsModel struct {",
+	} {
+		if !strings.Contains(td.Models, expected) {
+			t.Errorf("models do not contain %s:\n%s", expected, td.Models)
+		}
+	}
+
+	if got, expected := r.skips, []string{
+		"resourceWidget: annotation @WrappedImport(false) applies only to SDKv2 resources",
+		"protocol: StateFunc protocolStateFunc; normalize values with a custom type",
+	}; !slices.Equal(got, expected) {
+		t.Errorf("skips: got %v, expected %v", got, expected)
+	}
+
+	if len(td.Upgraders) != 1 {
+		t.Fatalf("got %d upgraders, expected 1", len(td.Upgraders))
+	}
+	up := td.Upgraders[0]
+	if got, expected := up.SchemaFunc, "widgetResourceSchemaV0"; got != expected {
+		t.Errorf("SchemaFunc: got %s, expected %s", got, expected)
+	}
+	if got, expected := up.Copies, []string{"ARN", "ID", "Name"}; !slices.Equal(got, expected) {
+		t.Errorf("Copies: got %v, expected %v", got, expected)
+	}
+	if !slices.Contains(up.Todos, "Size") {
+		t.Errorf("Todos: got %v, expected Size", up.Todos)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	td, _ := testMigrate(t)
+
+	for _, v := range []struct {
+		name, tmpl string
+	}{
+		{"resource.go", resourceTmpl},
+		{"migrate.go", migrateTmpl},
+	} {
+		var buffer bytes.Buffer
+		if err := template.Must(template.New(v.name).Parse(v.tmpl)).Execute(&buffer, td); err != nil {
+			t.Fatalf("%s: executing template: %s", v.name, err)
+		}
+
+		if _, err := fwschema.Format(v.name, buffer.Bytes()); err != nil {
+			t.Errorf("%s: formatting: %s\n%s", v.name, err, buffer.String())
+		}
+	}
+}
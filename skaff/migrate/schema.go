@@ -0,0 +1,639 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+)
+
+// translator translates SDKv2 schemas to Plugin Framework schemas.
+type translator struct {
+	src                *source
+	report             *report
+	resourceLowerCamel string          // e.g. flowLog.
+	modelSuffix        string          // Suffix of nested model names, e.g. V0 for a prior schema.
+	models             map[string]bool // Nested model names in use.
+	configValidators   []string
+}
+
+// timeout is a default operation timeout, e.g. Create: 10 * time.Minute.
+type timeout struct {
+	Operation string
+	Duration  string
+}
+
+// primitiveKinds maps SDKv2 primitive value types to attribute kinds.
+var primitiveKinds = map[string]fwschema.Kind{
+	"schema.TypeBool":   fwschema.KindBool,
+	"schema.TypeFloat":  fwschema.KindFloat64,
+	"schema.TypeInt":    fwschema.KindInt64,
+	"schema.TypeString": fwschema.KindString,
+}
+
+// schemaFields are the schema.Schema fields that are translated, or deliberately ignored.
+var schemaFields = []string{
+	"AtLeastOneOf",
+	"Computed",
+	"ConflictsWith",
+	"Default",
+	"Deprecated",
+	"Description",
+	"DiffSuppressFunc",
+	"DiffSuppressOnRefresh",
+	"Elem",
+	"ExactlyOneOf",
+	"ForceNew",
+	"MaxItems",
+	"MinItems",
+	"Optional",
+	"Required",
+	"RequiredWith",
+	"Sensitive",
+	"Type",
+	"ValidateDiagFunc",
+	"ValidateFunc",
+}
+
+// tagsAttributes maps the provider's SDKv2 tags schemas to their framework equivalents.
+var tagsAttributes = map[string]string{
+	"tftags.TagsSchema":         "tftags.TagsAttribute()",
+	"tftags.TagsSchemaComputed": "tftags.TagsAttributeComputedOnly()",
+	"tftags.TagsSchemaForceNew": "tftags.TagsAttributeForceNew()",
+}
+
+// resourceSchema translates an SDKv2 schema.Resource literal's schema and timeouts.
+func (t *translator) resourceSchema(res *ast.CompositeLit) (*fwschema.Schema, []timeout, error) {
+	f := fields(res)
+
+	m, err := t.schemaMap(f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s := &fwschema.Schema{}
+	s.Attributes, s.Blocks = t.properties("", m)
+
+	if v, ok := f["SchemaVersion"]; ok {
+		n, ok := t.src.intValue(v)
+		if !ok {
+			return nil, nil, fmt.Errorf("SchemaVersion %s is not a constant", t.describe(v))
+		}
+		s.Version = int64(n)
+	}
+
+	// The SDK adds the id attribute implicitly.
+	if s.Attribute(names.AttrID) == nil {
+		s.Attributes = append(s.Attributes, &fwschema.Attribute{
+			Name:      names.AttrID,
+			FieldName: "ID",
+			Type:      fwschema.Type{Kind: fwschema.KindString, Model: "types.String", Imports: []string{fwschema.ImportTypes}},
+			Expr:      "framework.IDAttribute()",
+		})
+	}
+
+	var timeouts []timeout
+	if v, ok := f["Timeouts"]; ok {
+		timeouts = t.timeouts(v)
+
+		opts := make([]string, len(timeouts))
+		for i, v := range timeouts {
+			opts[i] = v.Operation + ": true,"
+		}
+		s.Blocks = append(s.Blocks, &fwschema.Block{
+			Name:      "timeouts",
+			FieldName: "Timeouts",
+			Expr:      fmt.Sprintf("timeouts.Block(ctx, timeouts.Opts{\n%s\n})", strings.Join(opts, "\n")),
+			ModelType: "timeouts.Value",
+		})
+	}
+
+	s.Sort()
+
+	return s, timeouts, nil
+}
+
+// schemaMap returns the map[string]*schema.Schema literal of a schema.Resource's Schema or SchemaFunc.
+func (t *translator) schemaMap(f map[string]ast.Expr) (*ast.CompositeLit, error) {
+	if v, ok := f["Schema"]; ok {
+		if lit, ok := t.src.compositeLit(v); ok {
+			return lit, nil
+		}
+		return nil, fmt.Errorf("Schema %s is not a map literal", t.describe(v))
+	}
+
+	if v, ok := f["SchemaFunc"]; ok {
+		result, ok := t.src.funcResult(v)
+		if !ok {
+			// Schema functions sometimes declare local helpers before returning the map.
+			if fn, isLit := v.(*ast.FuncLit); isLit && len(fn.Body.List) > 0 {
+				if ret, isRet := fn.Body.List[len(fn.Body.List)-1].(*ast.ReturnStmt); isRet && len(ret.Results) == 1 {
+					result, ok = ret.Results[0], true
+				}
+			}
+		}
+		if ok {
+			if lit, ok := t.src.compositeLit(result); ok {
+				return lit, nil
+			}
+		}
+		return nil, fmt.Errorf("SchemaFunc %s does not return a map literal", t.describe(v))
+	}
+
+	return nil, errors.New("no Schema or SchemaFunc")
+}
+
+// timeouts translates a schema.ResourceTimeout literal.
+func (t *translator) timeouts(e ast.Expr) []timeout {
+	lit, ok := t.src.compositeLit(e)
+	if !ok {
+		t.report.skipped("timeouts", fmt.Sprintf("%s is not a literal", t.describe(e)))
+		return nil
+	}
+
+	f := fields(lit)
+	var timeouts []timeout
+	for _, operation := range []string{"Create", "Read", "Update", "Delete"} {
+		v, ok := f[operation]
+		if !ok {
+			continue
+		}
+
+		call, ok := v.(*ast.CallExpr)
+		if !ok || selectorName(call.Fun) != "schema.DefaultTimeout" || len(call.Args) != 1 {
+			t.report.skipped("timeouts", fmt.Sprintf("%s: %s is not a schema.DefaultTimeout call", operation, t.describe(v)))
+			continue
+		}
+		timeouts = append(timeouts, timeout{Operation: operation, Duration: t.src.exprString(call.Args[0])})
+	}
+	if _, ok := f["Default"]; ok {
+		t.report.skipped("timeouts", "Default; set each operation's default timeout instead")
+	}
+
+	return timeouts
+}
+
+// properties translates a map[string]*schema.Schema literal's elements.
+func (t *translator) properties(path string, lit *ast.CompositeLit) ([]*fwschema.Attribute, []*fwschema.Block) {
+	var attributes []*fwschema.Attribute
+	var blocks []*fwschema.Block
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		name, ok := t.src.stringValue(kv.Key)
+		if !ok {
+			t.report.skipped(path+t.describe(kv.Key), "attribute name is not a constant")
+			continue
+		}
+
+		attribute, block := t.property(path, name, kv.Value)
+		if attribute != nil {
+			attributes = append(attributes, attribute)
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return attributes, blocks
+}
+
+// property translates a schema.Schema to an attribute or a block.
+func (t *translator) property(path, name string, e ast.Expr) (*fwschema.Attribute, *fwschema.Block) {
+	p := path + name
+
+	if call, ok := e.(*ast.CallExpr); ok {
+		if expr, ok := tagsAttributes[selectorName(call.Fun)]; ok {
+			return &fwschema.Attribute{
+				Name:      name,
+				FieldName: fieldName(name),
+				Type:      fwschema.Type{Model: "tftags.Map", Imports: []string{fwschema.ImportTags}},
+				Expr:      expr,
+			}, nil
+		}
+	}
+
+	lit, ok := t.src.compositeLit(e)
+	if !ok {
+		t.report.skipped(p, fmt.Sprintf("%s is not a schema literal", t.describe(e)))
+		return nil, nil
+	}
+
+	f := fields(lit)
+	for _, key := range slices.Sorted(maps.Keys(f)) {
+		v := f[key]
+		switch {
+		case slices.Contains(schemaFields, key):
+		case key == "Set" && selectorName(v) == "schema.HashString":
+			// Framework sets need no hash function.
+		case key == "StateFunc":
+			t.report.skipped(p, fmt.Sprintf("StateFunc %s; normalize values with a custom type", t.describe(v)))
+		default:
+			t.report.skipped(p, fmt.Sprintf("%s %s", key, t.describe(v)))
+		}
+	}
+
+	typ := selectorName(f["Type"])
+	if kind, ok := primitiveKinds[typ]; ok {
+		return t.primitive(p, name, kind, f), nil
+	}
+
+	switch typ {
+	case "nullable.TypeNullableBool", "nullable.TypeNullableFloat", "nullable.TypeNullableInt":
+		// The SDKv2 state holds these values as strings.
+		t.report.skipped(p, fmt.Sprintf("Type %s; translated as a string to keep state compatible", typ))
+		return t.primitive(p, name, fwschema.KindString, f), nil
+
+	case "schema.TypeMap":
+		return t.collection(p, name, fwschema.KindMap, f), nil
+
+	case "schema.TypeList", "schema.TypeSet":
+		kind := fwschema.KindList
+		if typ == "schema.TypeSet" {
+			kind = fwschema.KindSet
+		}
+
+		if elem, ok := t.src.compositeLit(f["Elem"]); ok && selectorName(elem.Type) == "schema.Resource" {
+			return t.nested(p, name, kind, f, elem)
+		}
+
+		return t.collection(p, name, kind, f), nil
+	}
+
+	t.report.skipped(p, fmt.Sprintf("unsupported Type %s", t.describe(f["Type"])))
+	return nil, nil
+}
+
+// primitive translates a schema.Schema of a primitive type.
+func (t *translator) primitive(path, name string, kind fwschema.Kind, f map[string]ast.Expr) *fwschema.Attribute {
+	var v validation
+	t.validation(path, kind, f, &v)
+
+	a := &fwschema.Attribute{
+		Name:       name,
+		FieldName:  fieldName(name),
+		Type:       scalarType(kind, &v),
+		Validators: v.validators,
+	}
+	t.flags(path, a, f)
+	a.Validators = append(a.Validators, t.constraints(path, kind, f)...)
+
+	if e, ok := f["Default"]; ok {
+		a.Default = t.defaultValue(kind, &v, e)
+		a.Computed = true
+	}
+
+	return a
+}
+
+// collection translates a schema.Schema of a list, set or map of primitive values.
+func (t *translator) collection(path, name string, kind fwschema.Kind, f map[string]ast.Expr) *fwschema.Attribute {
+	elemKind := fwschema.KindString
+	var v validation
+	if e, ok := f["Elem"]; ok {
+		elem, ok := t.src.compositeLit(e)
+		if !ok {
+			t.report.skipped(path, fmt.Sprintf("Elem %s is not a literal; assuming strings", t.describe(e)))
+		} else {
+			ef := fields(elem)
+			if k, ok := primitiveKinds[selectorName(ef["Type"])]; ok {
+				elemKind = k
+			} else {
+				t.report.skipped(path, fmt.Sprintf("Elem type %s; assuming strings", t.describe(ef["Type"])))
+			}
+			t.validation(path+".*", elemKind, ef, &v)
+		}
+	} else if kind != fwschema.KindMap {
+		t.report.skipped(path, "no Elem; assuming strings")
+	}
+
+	a := &fwschema.Attribute{
+		Name:      name,
+		FieldName: fieldName(name),
+		Type:      collectionType(kind, elemKind, &v),
+	}
+	if len(v.validators) > 0 {
+		a.Validators = append(a.Validators, fmt.Sprintf("%s.Value%ssAre(%s)", validatorPackage(kind), elemKind, strings.Join(v.validators, ", ")))
+	}
+	a.Validators = append(a.Validators, t.sizeValidators(kind, f)...)
+	t.flags(path, a, f)
+	a.Validators = append(a.Validators, t.constraints(path, kind, f)...)
+
+	if e, ok := f["Default"]; ok {
+		t.report.skipped(path, fmt.Sprintf("Default %s on a collection", t.describe(e)))
+	}
+
+	return a
+}
+
+// nested translates a schema.Schema of a list or set of objects to a block,
+// or to a nested object attribute if it is computed only.
+func (t *translator) nested(path, name string, kind fwschema.Kind, f map[string]ast.Expr, elem *ast.CompositeLit) (*fwschema.Attribute, *fwschema.Block) {
+	m, err := t.schemaMap(fields(elem))
+	if err != nil {
+		t.report.skipped(path, err.Error())
+		return nil, nil
+	}
+
+	b := &fwschema.Block{
+		Name:      name,
+		FieldName: fieldName(name),
+		Set:       kind == fwschema.KindSet,
+	}
+	b.Model = t.modelName(b.FieldName)
+	b.Attributes, b.Blocks = t.properties(path+".", m)
+
+	required, optional, computed := isTrue(f["Required"]), isTrue(f["Optional"]), isTrue(f["Computed"])
+	if computed && !optional && !required {
+		return &fwschema.Attribute{
+			Name:      name,
+			FieldName: b.FieldName,
+			Type: fwschema.Type{
+				Kind:        kind,
+				Model:       b.ModelFieldType(),
+				CustomType:  fmt.Sprintf("fwtypes.New%sNestedObjectTypeOf[%s](ctx)", kind, b.Model),
+				ElementType: fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", b.Model),
+				Imports:     []string{fwschema.ImportFWTypes},
+			},
+			Computed:           true,
+			UseStateForUnknown: true,
+			Nested:             b,
+		}, nil
+	}
+	if computed {
+		t.report.todo("%s: the block was optional and computed, which blocks cannot be; consider a nested attribute", path)
+	}
+
+	if v, ok := f["MinItems"]; ok {
+		b.MinItems, _ = t.src.intValue(v)
+	}
+	if v, ok := f["MaxItems"]; ok {
+		b.MaxItems, _ = t.src.intValue(v)
+	}
+	if required {
+		b.MinItems = max(b.MinItems, 1)
+	}
+	b.RequiresReplace = isTrue(f["ForceNew"])
+	b.DeprecationMessage, _ = t.src.stringValue(f["Deprecated"])
+	b.Validators = t.constraints(path, kind, f)
+
+	for _, key := range []string{"Default", "DiffSuppressFunc", "ValidateFunc", "ValidateDiagFunc"} {
+		if v, ok := f[key]; ok && selectorName(v) != "verify.SuppressMissingOptionalConfigurationBlock" {
+			t.report.skipped(path, fmt.Sprintf("%s %s on a block", key, t.describe(v)))
+		}
+	}
+
+	return nil, b
+}
+
+// validation translates a schema.Schema's validation and diff suppression functions.
+func (t *translator) validation(path string, kind fwschema.Kind, f map[string]ast.Expr, v *validation) {
+	for _, key := range []string{"ValidateFunc", "ValidateDiagFunc"} {
+		if e, ok := f[key]; ok {
+			t.validate(path, kind, e, v)
+		}
+	}
+	if e, ok := f["DiffSuppressFunc"]; ok {
+		t.diffSuppress(path, e, v)
+	}
+
+	// The custom types validate their own values.
+	if v.valueType == valueTypeJSON || v.valueType == valueTypeIAMPolicy {
+		v.validators = slices.DeleteFunc(v.validators, func(s string) bool { return s == "fwvalidators.JSON()" })
+	}
+}
+
+// flags translates a schema.Schema's behavior flags.
+func (t *translator) flags(path string, a *fwschema.Attribute, f map[string]ast.Expr) {
+	a.Required = isTrue(f["Required"])
+	a.Optional = isTrue(f["Optional"])
+	a.Computed = isTrue(f["Computed"])
+	a.Sensitive = isTrue(f["Sensitive"])
+	a.RequiresReplace = isTrue(f["ForceNew"])
+	// The SDK keeps computed values in the plan unless they are marked as changing.
+	a.UseStateForUnknown = a.Computed
+	if v, ok := f["Deprecated"]; ok {
+		if a.DeprecationMessage, ok = t.src.stringValue(v); !ok {
+			t.report.skipped(path, fmt.Sprintf("Deprecated %s is not a constant", t.describe(v)))
+		}
+	}
+}
+
+// sizeValidators translates a collection's MinItems and MaxItems.
+func (t *translator) sizeValidators(kind fwschema.Kind, f map[string]ast.Expr) []string {
+	var validators []string
+	for _, v := range []struct {
+		key, validator string
+	}{
+		{"MinItems", "SizeAtLeast"},
+		{"MaxItems", "SizeAtMost"},
+	} {
+		if e, ok := f[v.key]; ok {
+			validators = append(validators, fmt.Sprintf("%s.%s(%s)", validatorPackage(kind), v.validator, t.src.exprString(e)))
+		}
+	}
+
+	return validators
+}
+
+// constraints translates a schema.Schema's constraints on other attributes.
+// ConflictsWith and RequiredWith become attribute validators; ExactlyOneOf and AtLeastOneOf, which the SDK
+// repeats on each attribute, become resource config validators.
+func (t *translator) constraints(path string, kind fwschema.Kind, f map[string]ast.Expr) []string {
+	var validators []string
+	for _, v := range []struct {
+		key, validator string
+		resource       bool
+	}{
+		{"ConflictsWith", "ConflictsWith", false},
+		{"RequiredWith", "AlsoRequires", false},
+		{"ExactlyOneOf", "ExactlyOneOf", true},
+		{"AtLeastOneOf", "AtLeastOneOf", true},
+	} {
+		e, ok := f[v.key]
+		if !ok {
+			continue
+		}
+
+		keys, ok := t.src.stringValues(e)
+		if !ok {
+			t.report.skipped(path, fmt.Sprintf("%s %s is not a constant", v.key, t.describe(e)))
+			continue
+		}
+		if strings.Contains(path, ".") || slices.ContainsFunc(keys, func(s string) bool { return strings.Contains(s, ".") }) {
+			t.report.skipped(path, fmt.Sprintf("%s on nested attributes; use path.MatchRelative() expressions", v.key))
+			continue
+		}
+
+		exprs := make([]string, len(keys))
+		for i, key := range keys {
+			exprs[i] = fmt.Sprintf("path.MatchRoot(%s)", namesgen.ConstOrQuote(key))
+		}
+
+		if v.resource {
+			validator := fmt.Sprintf("resourcevalidator.%s(\n%s,\n)", v.validator, strings.Join(exprs, ",\n"))
+			if !slices.Contains(t.configValidators, validator) {
+				t.configValidators = append(t.configValidators, validator)
+			}
+		} else {
+			validators = append(validators, fmt.Sprintf("%s.%s(%s)", validatorPackage(kind), v.validator, strings.Join(exprs, ", ")))
+		}
+	}
+
+	return validators
+}
+
+// defaultValue translates a primitive attribute's Default.
+func (t *translator) defaultValue(kind fwschema.Kind, v *validation, e ast.Expr) string {
+	expr := t.src.exprString(e)
+
+	switch kind {
+	case fwschema.KindBool:
+		return fmt.Sprintf("booldefault.StaticBool(%s)", expr)
+	case fwschema.KindFloat64:
+		return fmt.Sprintf("float64default.StaticFloat64(%s)", expr)
+	case fwschema.KindInt64:
+		return fmt.Sprintf("int64default.StaticInt64(%s)", expr)
+	}
+
+	// Enum defaults are typed constants, e.g. awstypes.TrafficTypeAll.
+	if sel, ok := e.(*ast.SelectorExpr); ok && selectorName(sel.X) != "names" {
+		if v.enum != "" {
+			return fmt.Sprintf("fwtypes.StringEnumType[%s]().AttributeDefault(%s)", v.enum, expr)
+		}
+		expr = fmt.Sprintf("string(%s)", expr)
+	}
+
+	return fmt.Sprintf("stringdefault.StaticString(%s)", expr)
+}
+
+// modelName returns an unused nested model struct name for a field, e.g. destinationOptionsModel.
+func (t *translator) modelName(fieldName string) string {
+	candidates := []string{
+		convert.ToLowercasePrefix(fieldName) + "Model" + t.modelSuffix,
+		t.resourceLowerCamel + fieldName + "Model" + t.modelSuffix,
+	}
+	for i := 2; ; i++ {
+		for _, name := range candidates {
+			if !t.models[name] && !t.src.idents[name] {
+				t.models[name] = true
+				return name
+			}
+		}
+		candidates = []string{fmt.Sprintf("%s%sModel%d%s", t.resourceLowerCamel, fieldName, i, t.modelSuffix)}
+	}
+}
+
+// scalarType returns the type of a primitive attribute.
+func scalarType(kind fwschema.Kind, v *validation) fwschema.Type {
+	if kind == fwschema.KindString {
+		if v.enum != "" {
+			return fwschema.Type{
+				Kind:       kind,
+				Model:      fmt.Sprintf("fwtypes.StringEnum[%s]", v.enum),
+				CustomType: fmt.Sprintf("fwtypes.StringEnumType[%s]()", v.enum),
+				Imports:    []string{fwschema.ImportFWTypes},
+			}
+		}
+
+		switch v.valueType {
+		case valueTypeARN:
+			return fwtypesType(kind, "ARN")
+		case valueTypeCaseInsensitive:
+			return fwtypesType(kind, "CaseInsensitiveString")
+		case valueTypeCIDRBlock:
+			return fwtypesType(kind, "CIDRBlock")
+		case valueTypeIAMPolicy:
+			return fwtypesType(kind, "IAMPolicy")
+		case valueTypeJSON:
+			return fwschema.Type{
+				Kind:       kind,
+				Model:      "jsontypes.Normalized",
+				CustomType: "jsontypes.NormalizedType{}",
+				Imports:    []string{fwschema.ImportJSONTypes},
+			}
+		case valueTypeRFC3339:
+			return fwschema.Type{
+				Kind:       kind,
+				Model:      "timetypes.RFC3339",
+				CustomType: "timetypes.RFC3339Type{}",
+				Imports:    []string{fwschema.ImportTimeTypes},
+			}
+		}
+	}
+
+	return fwschema.Type{
+		Kind:    kind,
+		Model:   "types." + string(kind),
+		Imports: []string{fwschema.ImportTypes},
+	}
+}
+
+func fwtypesType(kind fwschema.Kind, name string) fwschema.Type {
+	return fwschema.Type{
+		Kind:       kind,
+		Model:      "fwtypes." + name,
+		CustomType: "fwtypes." + name + "Type",
+		Imports:    []string{fwschema.ImportFWTypes},
+	}
+}
+
+// collectionType returns the type of a list, set or map attribute.
+func collectionType(kind, elemKind fwschema.Kind, v *validation) fwschema.Type {
+	if elemKind != fwschema.KindString {
+		return fwschema.Type{
+			Kind:        kind,
+			Model:       "types." + string(kind),
+			ElementType: fmt.Sprintf("types.%sType", elemKind),
+			Imports:     []string{fwschema.ImportTypes},
+		}
+	}
+
+	if kind != fwschema.KindMap {
+		switch {
+		case v.enum != "":
+			return fwschema.Type{
+				Kind:        kind,
+				Model:       fmt.Sprintf("fwtypes.%sOfStringEnum[%s]", kind, v.enum),
+				CustomType:  fmt.Sprintf("fwtypes.%sOfStringEnumType[%s]()", kind, v.enum),
+				ElementType: fmt.Sprintf("fwtypes.StringEnumType[%s]()", v.enum),
+				Imports:     []string{fwschema.ImportFWTypes},
+			}
+		case v.valueType == valueTypeARN:
+			return fwschema.Type{
+				Kind:        kind,
+				Model:       fmt.Sprintf("fwtypes.%sOfARN", kind),
+				CustomType:  fmt.Sprintf("fwtypes.%sOfARNType", kind),
+				ElementType: "fwtypes.ARNType",
+				Imports:     []string{fwschema.ImportFWTypes},
+			}
+		}
+	}
+
+	return fwschema.Type{
+		Kind:        kind,
+		Model:       fmt.Sprintf("fwtypes.%sOfString", kind),
+		CustomType:  fmt.Sprintf("fwtypes.%sOfStringType", kind),
+		ElementType: "types.StringType",
+		Imports:     []string{fwschema.ImportFWTypes},
+	}
+}
+
+// fieldName returns the model struct field name for an attribute, e.g. iam_role_arn becomes IAMRoleARN.
+func fieldName(name string) string {
+	return convert.ToProviderCapitalization(names.ToCamelCase(name))
+}
@@ -0,0 +1,385 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+	"golang.org/x/tools/go/packages"
+)
+
+const namesPackage = "github.com/hashicorp/terraform-provider-aws/names"
+
+// source is the parsed source of a service package.
+type source struct {
+	fset   *token.FileSet
+	funcs  map[string]*ast.FuncDecl
+	values map[string]ast.Expr  // Package-level constant and variable values.
+	idents map[string]bool      // Package-level identifiers.
+	files  map[string]*ast.File // Files by base name.
+	names  map[string]string    // String constants of the names package, e.g. AttrARN.
+}
+
+// parseDir parses the non-test Go files in a directory.
+func parseDir(dir string) (*source, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", filename, err)
+		}
+
+		f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+		files = append(files, f)
+	}
+
+	return newSource(fset, files...), nil
+}
+
+func newSource(fset *token.FileSet, files ...*ast.File) *source {
+	s := &source{
+		fset:   fset,
+		funcs:  make(map[string]*ast.FuncDecl),
+		values: make(map[string]ast.Expr),
+		idents: make(map[string]bool),
+		files:  make(map[string]*ast.File),
+		names:  make(map[string]string),
+	}
+
+	for _, f := range files {
+		s.files[s.filename(f)] = f
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					s.funcs[decl.Name.Name] = decl
+					s.idents[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						s.idents[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for i, name := range spec.Names {
+							s.idents[name.Name] = true
+							if i < len(spec.Values) {
+								s.values[name.Name] = spec.Values[i]
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+// without returns the source without the named files, e.g. the output of an earlier migration.
+func (s *source) without(filenames ...string) *source {
+	var files []*ast.File
+	for _, name := range slices.Sorted(maps.Keys(s.files)) {
+		if !slices.Contains(filenames, name) {
+			files = append(files, s.files[name])
+		}
+	}
+
+	v := newSource(s.fset, files...)
+	v.names = s.names
+
+	return v
+}
+
+// loadNames loads the string constants of the names package.
+func (s *source) loadNames() error {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+	}
+	pkgs, err := packages.Load(cfg, namesPackage)
+	if err != nil {
+		return fmt.Errorf("loading %s: %w", namesPackage, err)
+	}
+	if len(pkgs) != 1 || len(pkgs[0].Errors) > 0 {
+		return fmt.Errorf("loading %s: %v", namesPackage, pkgs[0].Errors)
+	}
+
+	scope := pkgs[0].Types.Scope()
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && c.Val().Kind() == constant.String {
+			s.names[name] = constant.StringVal(c.Val())
+		}
+	}
+
+	return nil
+}
+
+// filename returns the base name of the file containing a node.
+func (s *source) filename(node ast.Node) string {
+	return filepath.Base(s.fset.Position(node.Pos()).Filename)
+}
+
+// exprString returns the source text of an expression.
+func (s *source) exprString(e ast.Expr) string {
+	var buffer bytes.Buffer
+	if err := printer.Fprint(&buffer, s.fset, e); err != nil {
+		return types.ExprString(e)
+	}
+
+	return buffer.String()
+}
+
+// resolve follows package-level variables and calls of parameterless package functions
+// that return a single expression (e.g. func fooSchema() *schema.Schema { return &schema.Schema{...} }).
+func (s *source) resolve(e ast.Expr) ast.Expr {
+	for range 10 {
+		switch v := e.(type) {
+		case *ast.ParenExpr:
+			e = v.X
+			continue
+		case *ast.Ident:
+			if value, ok := s.values[v.Name]; ok {
+				e = value
+				continue
+			}
+		case *ast.CallExpr:
+			if result, ok := s.funcResult(v.Fun); ok && len(v.Args) == 0 {
+				e = result
+				continue
+			}
+		}
+
+		return e
+	}
+
+	return e
+}
+
+// funcResult returns the expression returned by a parameterless function literal or package function
+// whose body is a single return statement.
+func (s *source) funcResult(e ast.Expr) (ast.Expr, bool) {
+	var typ *ast.FuncType
+	var body *ast.BlockStmt
+	switch v := e.(type) {
+	case *ast.FuncLit:
+		typ, body = v.Type, v.Body
+	case *ast.Ident:
+		decl, ok := s.funcs[v.Name]
+		if !ok {
+			return nil, false
+		}
+		typ, body = decl.Type, decl.Body
+	default:
+		return nil, false
+	}
+
+	if typ.Params.NumFields() > 0 || body == nil || len(body.List) != 1 {
+		return nil, false
+	}
+	ret, ok := body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil, false
+	}
+
+	return ret.Results[0], true
+}
+
+// stringValue returns the value of a string literal, names package constant or package-level string constant.
+func (s *source) stringValue(e ast.Expr) (string, bool) {
+	switch v := s.resolve(e).(type) {
+	case *ast.BasicLit:
+		if v.Kind == token.STRING {
+			value, err := strconv.Unquote(v.Value)
+			return value, err == nil
+		}
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "names" {
+			value, ok := s.names[v.Sel.Name]
+			return value, ok
+		}
+	}
+
+	return "", false
+}
+
+// stringValues returns the values of a []string composite literal.
+func (s *source) stringValues(e ast.Expr) ([]string, bool) {
+	lit, ok := s.resolve(e).(*ast.CompositeLit)
+	if !ok {
+		return nil, false
+	}
+
+	var values []string
+	for _, elt := range lit.Elts {
+		value, ok := s.stringValue(elt)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, value)
+	}
+
+	return values, true
+}
+
+// intValue returns the value of an integer literal or package-level integer constant.
+func (s *source) intValue(e ast.Expr) (int, bool) {
+	if v, ok := s.resolve(e).(*ast.BasicLit); ok && v.Kind == token.INT {
+		value, err := strconv.Atoi(v.Value)
+		return value, err == nil
+	}
+
+	return 0, false
+}
+
+// addImports adds the imports of a source file to a generated file's imports, except
+// packages whose names are already in use (e.g. the SDKv2 schema package).
+// Unused imports are removed when the generated source is formatted.
+func (s *source) addImports(filename string, imports fwschema.Imports) {
+	f, ok := s.files[filename]
+	if !ok {
+		return
+	}
+
+	inUse := make(map[string]bool)
+	for importPath, alias := range imports {
+		inUse[cmp.Or(alias, path.Base(importPath))] = true
+	}
+
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == "_" || name == "." || inUse[name] {
+			continue
+		}
+
+		inUse[name] = true
+		if spec.Name != nil {
+			imports[importPath] = name
+		} else {
+			imports.Add(importPath)
+		}
+	}
+}
+
+// isTrue reports whether an expression is the identifier true.
+func isTrue(e ast.Expr) bool {
+	v, ok := e.(*ast.Ident)
+	return ok && v.Name == "true"
+}
+
+// fields returns a composite literal's keyed elements by key.
+func fields(lit *ast.CompositeLit) map[string]ast.Expr {
+	m := make(map[string]ast.Expr, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok {
+				m[key.Name] = kv.Value
+			}
+		}
+	}
+
+	return m
+}
+
+// compositeLit returns the composite literal of an expression such as &schema.Resource{...}.
+func (s *source) compositeLit(e ast.Expr) (*ast.CompositeLit, bool) {
+	e = s.resolve(e)
+	if v, ok := e.(*ast.UnaryExpr); ok && v.Op == token.AND {
+		e = v.X
+	}
+	lit, ok := e.(*ast.CompositeLit)
+
+	return lit, ok
+}
+
+// selectorName returns the qualified name of a selector or identifier, e.g. schema.TypeString.
+func selectorName(e ast.Expr) string {
+	switch v := e.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok {
+			return x.Name + "." + v.Sel.Name
+		}
+	case *ast.IndexExpr:
+		return selectorName(v.X)
+	}
+
+	return ""
+}
+
+// resourceFunc finds the function that returns the named SDKv2 resource.
+// The name is either the function name without its "resource" prefix (e.g. FlowLog)
+// or the resource type name in its @SDKResource annotation (e.g. aws_flow_log).
+func (s *source) resourceFunc(name string) (*ast.FuncDecl, error) {
+	if !strings.HasPrefix(name, "aws_") {
+		decl, ok := s.funcs["resource"+name]
+		if !ok {
+			return nil, fmt.Errorf("function resource%s not found", name)
+		}
+		return decl, nil
+	}
+
+	annotation := fmt.Sprintf("@SDKResource(%q", name)
+	for _, key := range slices.Sorted(maps.Keys(s.funcs)) {
+		decl := s.funcs[key]
+		if slices.ContainsFunc(annotations(decl.Doc), func(v string) bool { return strings.HasPrefix(v, annotation) }) {
+			return decl, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no function annotated %s)", annotation)
+}
+
+// annotations returns the @-annotations in a doc comment, without their comment markers.
+func annotations(doc *ast.CommentGroup) []string {
+	if doc == nil {
+		return nil
+	}
+
+	var values []string
+	for _, c := range doc.List {
+		if v, ok := strings.CutPrefix(c.Text, "// @"); ok {
+			values = append(values, "@"+v)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/fwschema"
+)
+
+// valueType is a custom value type implied by an SDKv2 validation or diff suppression function.
+type valueType int
+
+const (
+	valueTypeNone valueType = iota
+	valueTypeARN
+	valueTypeCaseInsensitive
+	valueTypeCIDRBlock
+	valueTypeIAMPolicy
+	valueTypeJSON
+	valueTypeRFC3339
+)
+
+// validation is the translation of an SDKv2 attribute's validation and diff suppression functions.
+type validation struct {
+	validators []string  // Framework validator expressions.
+	enum       string    // SDK enum type, e.g. awstypes.TrafficType.
+	valueType  valueType // Custom value type, if any.
+}
+
+// simpleValidators maps SDKv2 validation functions that take arguments to framework validators.
+// The format verbs are the SDKv2 function's arguments, in order.
+var simpleValidators = map[string]struct {
+	kind   fwschema.Kind
+	format string
+}{
+	"validation.FloatAtLeast":     {fwschema.KindFloat64, "float64validator.AtLeast(%s)"},
+	"validation.FloatAtMost":      {fwschema.KindFloat64, "float64validator.AtMost(%s)"},
+	"validation.FloatBetween":     {fwschema.KindFloat64, "float64validator.Between(%s, %s)"},
+	"validation.IntAtLeast":       {fwschema.KindInt64, "int64validator.AtLeast(%s)"},
+	"validation.IntAtMost":        {fwschema.KindInt64, "int64validator.AtMost(%s)"},
+	"validation.IntBetween":       {fwschema.KindInt64, "int64validator.Between(%s, %s)"},
+	"validation.StringLenBetween": {fwschema.KindString, "stringvalidator.LengthBetween(%s, %s)"},
+	"validation.StringMatch":      {fwschema.KindString, "stringvalidator.RegexMatches(%s, %s)"},
+}
+
+// funcValidators maps SDKv2 validation function values to framework validators, by attribute kind.
+var funcValidators = map[string]map[fwschema.Kind]string{
+	"validation.IsIPv4Address":           {fwschema.KindString: "fwvalidators.IPv4Address()"},
+	"validation.IsIPv6Address":           {fwschema.KindString: "fwvalidators.IPv6Address()"},
+	"validation.IsPortNumber":            {fwschema.KindInt64: "int64validator.Between(1, 65535)"},
+	"validation.IsPortNumberOrZero":      {fwschema.KindInt64: "int64validator.Between(0, 65535)"},
+	"validation.NoZeroValues":            {fwschema.KindString: "stringvalidator.LengthAtLeast(1)", fwschema.KindInt64: "int64validator.NoneOf(0)", fwschema.KindFloat64: "float64validator.NoneOf(0)"},
+	"validation.StringIsJSON":            {fwschema.KindString: "fwvalidators.JSON()"},
+	"validation.StringIsNotEmpty":        {fwschema.KindString: "stringvalidator.LengthAtLeast(1)"},
+	"verify.ValidAccountID":              {fwschema.KindString: "fwvalidators.AWSAccountID()"},
+	"verify.ValidIPv4CIDRNetworkAddress": {fwschema.KindString: "fwvalidators.IPv4CIDRNetworkAddress()"},
+	"verify.ValidIPv6CIDRNetworkAddress": {fwschema.KindString: "fwvalidators.IPv6CIDRNetworkAddress()"},
+	"verify.ValidRegionName":             {fwschema.KindString: "fwvalidators.AWSRegion()"},
+	"verify.ValidServicePrincipal":       {fwschema.KindString: "fwvalidators.ServicePrincipal()"},
+}
+
+// valueTypeValidators maps SDKv2 validation functions to the custom value types that replace them.
+var valueTypeValidators = map[string]valueType{
+	"validation.IsRFC3339Time":       valueTypeRFC3339,
+	"verify.ValidARN":                valueTypeARN,
+	"verify.ValidARNCheck":           valueTypeARN,
+	"verify.ValidCIDRNetworkAddress": valueTypeCIDRBlock,
+	"verify.ValidIAMPolicyJSON":      valueTypeIAMPolicy,
+	"verify.ValidUTCTimestamp":       valueTypeRFC3339,
+}
+
+// diffSuppressValueTypes maps SDKv2 diff suppression functions to the custom value types that replace them.
+var diffSuppressValueTypes = map[string]valueType{
+	"sdkv2.SuppressEquivalentStringCaseInsensitive": valueTypeCaseInsensitive,
+	"verify.SuppressEquivalentJSONDiffs":            valueTypeJSON,
+	"verify.SuppressEquivalentPolicyDiffs":          valueTypeIAMPolicy,
+}
+
+// validate translates an SDKv2 ValidateFunc or ValidateDiagFunc for an attribute of the given kind.
+func (t *translator) validate(path string, kind fwschema.Kind, e ast.Expr, v *validation) {
+	call, isCall := e.(*ast.CallExpr)
+	if !isCall {
+		name := selectorName(e)
+		if vt, ok := valueTypeValidators[name]; ok {
+			v.valueType = vt
+			return
+		}
+		if expr, ok := funcValidators[name][kind]; ok {
+			v.validators = append(v.validators, expr)
+			return
+		}
+		t.report.skipped(path, fmt.Sprintf("validator %s", t.describe(e)))
+		return
+	}
+
+	name := selectorName(call.Fun)
+	switch name {
+	case "validation.All", "validation.AllDiag":
+		for _, arg := range call.Args {
+			t.validate(path, kind, arg, v)
+		}
+		return
+
+	case "validation.ToDiagFunc":
+		if len(call.Args) == 1 {
+			t.validate(path, kind, call.Args[0], v)
+			return
+		}
+
+	case "enum.Validate", "enum.ValidateIgnoreCase":
+		if index, ok := call.Fun.(*ast.IndexExpr); ok && kind == fwschema.KindString {
+			v.enum = t.src.exprString(index.Index)
+			if name == "enum.ValidateIgnoreCase" {
+				t.report.todo("%s: enum values were validated case-insensitively", path)
+			}
+			return
+		}
+
+	case "validation.StringInSlice":
+		if len(call.Args) == 2 && kind == fwschema.KindString {
+			f := "stringvalidator.OneOf(%s)"
+			if isTrue(call.Args[1]) {
+				f = "stringvalidator.OneOfCaseInsensitive(%s)"
+			}
+			v.validators = append(v.validators, fmt.Sprintf(f, t.elements(call.Args[0])))
+			return
+		}
+
+	case "validation.IntInSlice":
+		// Only literal elements convert to int64 arguments.
+		if len(call.Args) == 1 && isCompositeLit(call.Args[0]) && kind == fwschema.KindInt64 {
+			v.validators = append(v.validators, fmt.Sprintf("int64validator.OneOf(%s)", t.elements(call.Args[0])))
+			return
+		}
+
+	default:
+		if vt, ok := valueTypeValidators[name]; ok {
+			v.valueType = vt
+			return
+		}
+		if sv, ok := simpleValidators[name]; ok && sv.kind == kind && strings.Count(sv.format, "%s") == len(call.Args) {
+			args := make([]any, len(call.Args))
+			for i, arg := range call.Args {
+				args[i] = t.src.exprString(arg)
+			}
+			v.validators = append(v.validators, fmt.Sprintf(sv.format, args...))
+			return
+		}
+	}
+
+	t.report.skipped(path, fmt.Sprintf("validator %s", t.describe(e)))
+}
+
+// diffSuppress translates an SDKv2 DiffSuppressFunc.
+func (t *translator) diffSuppress(path string, e ast.Expr, v *validation) {
+	name := selectorName(e)
+	if vt, ok := diffSuppressValueTypes[name]; ok {
+		v.valueType = vt
+		return
+	}
+
+	// Nested blocks that are absent from configuration are empty in the plan.
+	if name == "verify.SuppressMissingOptionalConfigurationBlock" {
+		return
+	}
+
+	t.report.skipped(path, fmt.Sprintf("DiffSuppressFunc %s; use a custom type or plan modifier", t.describe(e)))
+}
+
+// elements returns the elements of a slice composite literal as arguments, or the slice expanded.
+func (t *translator) elements(e ast.Expr) string {
+	if lit, ok := e.(*ast.CompositeLit); ok {
+		elts := make([]string, len(lit.Elts))
+		for i, elt := range lit.Elts {
+			elts[i] = t.src.exprString(elt)
+		}
+		return strings.Join(elts, ", ")
+	}
+
+	return t.src.exprString(e) + "..."
+}
+
+func isCompositeLit(e ast.Expr) bool {
+	_, ok := e.(*ast.CompositeLit)
+	return ok
+}
+
+// describe returns a short description of an expression for the report.
+func (t *translator) describe(e ast.Expr) string {
+	if _, ok := e.(*ast.FuncLit); ok {
+		return "(function literal)"
+	}
+
+	s := t.src.exprString(e)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i] + "..."
+	}
+
+	return s
+}
+
+// validatorPackage returns the framework validators package for an attribute kind, e.g. stringvalidator.
+func validatorPackage(kind fwschema.Kind) string {
+	return strings.ToLower(string(kind)) + "validator"
+}
@@ -0,0 +1,309 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdk inspects AWS SDK for Go v2 service packages so that provider
+// code can be generated from API operation shapes.
+package sdk
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	modulePrefix   = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMarker = "This member is required."
+)
+
+// Package is a loaded AWS SDK for Go v2 service package and its types package.
+type Package struct {
+	Name     string
+	service  *types.Package
+	awstypes *types.Package
+
+	// required holds, by qualified struct name, the names of fields documented as required.
+	required map[string]map[string]bool
+}
+
+// Operation is an API operation's input and output shapes.
+type Operation struct {
+	Name   string
+	Input  *Struct
+	Output *Struct
+}
+
+// Struct is an SDK structure.
+type Struct struct {
+	Name   string
+	Type   *types.Named
+	Fields []*Field
+}
+
+// Field is a field of an SDK structure.
+type Field struct {
+	Name     string
+	Type     types.Type
+	Required bool
+}
+
+// EnumValue is a value of an SDK string enum.
+type EnumValue struct {
+	Constant string // e.g. QueueStatusActive
+	Value    string // e.g. ACTIVE
+}
+
+// Load loads the named SDK service package (e.g. "cloudwatchlogs").
+// The package is resolved from the module in the current working directory.
+func Load(name string) (*Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+	}
+	path := modulePrefix + name
+	pkgs, err := packages.Load(cfg, path, path+"/types")
+	if err != nil {
+		return nil, fmt.Errorf("loading %s: %w", path, err)
+	}
+
+	p := &Package{
+		Name:     name,
+		required: make(map[string]map[string]bool),
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("loading %s: %s", pkg.PkgPath, pkg.Errors[0])
+		}
+
+		switch pkg.PkgPath {
+		case path:
+			p.service = pkg.Types
+		case path + "/types":
+			p.awstypes = pkg.Types
+		}
+
+		for _, file := range pkg.Syntax {
+			p.addRequiredFields(pkg.PkgPath, file)
+		}
+	}
+
+	if p.service == nil || p.awstypes == nil {
+		return nil, fmt.Errorf("loading %s: package not found", path)
+	}
+
+	return p, nil
+}
+
+func (p *Package) addRequiredFields(pkgPath string, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+
+		structType, ok := spec.Type.(*ast.StructType)
+		if !ok {
+			return false
+		}
+
+		for _, field := range structType.Fields.List {
+			if field.Doc == nil || !strings.Contains(field.Doc.Text(), requiredMarker) {
+				continue
+			}
+
+			key := pkgPath + "." + spec.Name.Name
+			if p.required[key] == nil {
+				p.required[key] = make(map[string]bool)
+			}
+			for _, name := range field.Names {
+				p.required[key][name.Name] = true
+			}
+		}
+
+		return false
+	})
+}
+
+// HasOperation returns whether the service client has the named operation.
+func (p *Package) HasOperation(name string) bool {
+	client, ok := p.service.Scope().Lookup("Client").(*types.TypeName)
+	if !ok {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(client.Type()), true, p.service, name)
+	_, ok = obj.(*types.Func)
+
+	return ok
+}
+
+// HasPaginator returns whether the service package has a paginator for the named operation.
+func (p *Package) HasPaginator(operation string) bool {
+	_, ok := p.service.Scope().Lookup("New" + operation + "Paginator").(*types.Func)
+
+	return ok
+}
+
+// Operation returns the named operation's input and output shapes.
+func (p *Package) Operation(name string) (*Operation, error) {
+	if !p.HasOperation(name) {
+		return nil, fmt.Errorf("%s: operation %s not found", p.Name, name)
+	}
+
+	input, err := p.lookupStruct(p.service, name+"Input")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := p.lookupStruct(p.service, name+"Output")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Operation{
+		Name:   name,
+		Input:  input,
+		Output: output,
+	}, nil
+}
+
+func (p *Package) lookupStruct(pkg *types.Package, name string) (*Struct, error) {
+	obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s: type %s not found", pkg.Path(), name)
+	}
+
+	v, ok := p.Struct(obj.Type())
+	if !ok {
+		return nil, fmt.Errorf("%s: type %s is not a struct", pkg.Path(), name)
+	}
+
+	return v, nil
+}
+
+// Struct returns the shape of an SDK struct type, or false if t is not a named struct type.
+// Pointers are dereferenced.
+func (p *Package) Struct(t types.Type) (*Struct, bool) {
+	named, ok := deref(t).(*types.Named)
+	if !ok {
+		return nil, false
+	}
+
+	underlying, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	obj := named.Obj()
+	required := p.required[obj.Pkg().Path()+"."+obj.Name()]
+
+	s := &Struct{
+		Name: obj.Name(),
+		Type: named,
+	}
+	for field := range underlying.Fields() {
+		if !field.Exported() || field.Name() == "ResultMetadata" {
+			continue
+		}
+
+		s.Fields = append(s.Fields, &Field{
+			Name:     field.Name(),
+			Type:     field.Type(),
+			Required: required[field.Name()],
+		})
+	}
+
+	return s, true
+}
+
+// Field returns the named field, or nil.
+func (s *Struct) Field(name string) *Field {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// IsTypesStruct returns whether t, after dereferencing, is a struct type from the SDK types package.
+func (p *Package) IsTypesStruct(t types.Type) bool {
+	named, ok := deref(t).(*types.Named)
+	if !ok || named.Obj().Pkg() != p.awstypes {
+		return false
+	}
+
+	_, ok = named.Underlying().(*types.Struct)
+
+	return ok
+}
+
+// Enum returns the values of an SDK string enum type, or false if t is not an enum type.
+func (p *Package) Enum(t types.Type) ([]EnumValue, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() != p.awstypes {
+		return nil, false
+	}
+
+	if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Kind() != types.String {
+		return nil, false
+	}
+
+	var values []EnumValue
+	scope := p.awstypes.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), named) {
+			continue
+		}
+
+		values = append(values, EnumValue{
+			Constant: name,
+			Value:    strings.Trim(c.Val().ExactString(), `"`),
+		})
+	}
+
+	return values, true
+}
+
+// ErrorType returns the first of the named error types that exists in the SDK types package.
+func (p *Package) ErrorType(names ...string) (string, bool) {
+	scope := p.awstypes.Scope()
+	i := slices.IndexFunc(names, func(name string) bool {
+		_, ok := scope.Lookup(name).(*types.TypeName)
+		return ok
+	})
+	if i < 0 {
+		return "", false
+	}
+
+	return names[i], true
+}
+
+// TypeName returns the name of t as written in provider code, with the SDK
+// types package imported as awstypes (e.g. *awstypes.Queue).
+func (p *Package) TypeName(t types.Type) string {
+	return types.TypeString(t, func(pkg *types.Package) string {
+		switch pkg {
+		case p.service:
+			return p.Name
+		case p.awstypes:
+			return "awstypes"
+		default:
+			return pkg.Name()
+		}
+	})
+}
+
+func deref(t types.Type) types.Type {
+	if ptr, ok := t.(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return t
+}